@@ -201,6 +201,13 @@ func startControllers(opts *options.Options, stopChan <-chan struct{}) {
 			klog.Info("Enabling RawResourceStatusCollection for all the enabled federated resources")
 		}
 
+		policyInformers, err := util.NewPolicyInformers(opts.Config)
+		if err != nil {
+			klog.Fatalf("Error creating policy informers: %v", err)
+		}
+		policyInformers.Run(stopChan)
+		opts.Config.PolicyInformers = policyInformers
+
 		if err := federatedtypeconfig.StartController(opts.Config, stopChan); err != nil {
 			klog.Fatalf("Error starting federated type config controller: %v", err)
 		}
@@ -380,6 +387,11 @@ func setOptionsByKubeFedConfig(opts *options.Options) {
 	opts.Config.MaxConcurrentStatusReconciles = *spec.StatusController.MaxConcurrentReconciles
 
 	opts.Config.SkipAdoptingResources = *spec.SyncController.AdoptResources == corev1b1.AdoptResourcesDisabled
+	opts.Config.OperationTimeout = spec.SyncController.OperationTimeout.Duration
+	opts.Config.MaxInFlightOperations = *spec.SyncController.MaxInFlightOperations
+
+	opts.Config.ClusterRateLimit.QPS = float32(*spec.ClusterRateLimit.QPS)
+	opts.Config.ClusterRateLimit.Burst = int(*spec.ClusterRateLimit.Burst)
 
 	var featureGates = make(map[string]bool)
 	for _, v := range fedConfig.Spec.FeatureGates {
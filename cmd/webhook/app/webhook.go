@@ -15,6 +15,9 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/manager/signals"
 	ctrwebhook "sigs.k8s.io/controller-runtime/pkg/webhook"
 
+	genericscheme "sigs.k8s.io/kubefed/pkg/client/generic/scheme"
+	"sigs.k8s.io/kubefed/pkg/controller/util"
+	"sigs.k8s.io/kubefed/pkg/controller/webhook/federatedresource"
 	"sigs.k8s.io/kubefed/pkg/controller/webhook/federatedtypeconfig"
 	"sigs.k8s.io/kubefed/pkg/controller/webhook/kubefedcluster"
 	"sigs.k8s.io/kubefed/pkg/controller/webhook/kubefedconfig"
@@ -24,6 +27,7 @@ import (
 var (
 	certDir, kubeconfig, masterURL string
 	port                           = 8443
+	kubefedNamespace               = util.DefaultKubeFedSystemNamespace
 )
 
 // NewWebhookCommand creates a *cobra.Command object with default parameters
@@ -55,6 +59,7 @@ func NewWebhookCommand(stopChan <-chan struct{}) *cobra.Command {
 	cmd.Flags().StringVar(&masterURL, "master", "", "The address of the Kubernetes API server. Overrides any value in kubeconfig. Only required if out-of-cluster.")
 	cmd.Flags().StringVar(&certDir, "cert-dir", "", "The directory where the TLS certs are located.")
 	cmd.Flags().IntVar(&port, "secure-port", port, "The port on which to serve HTTPS.")
+	cmd.Flags().StringVar(&kubefedNamespace, "kubefed-namespace", kubefedNamespace, "The namespace containing the FederatedTypeConfigs of the federated types to validate.")
 	cmd.Flags().BoolVar(&verFlag, "version", false, "Prints the Version info of webhook.")
 
 	return cmd
@@ -72,6 +77,10 @@ func Run(stopChan <-chan struct{}) error {
 	mgr, err := manager.New(config, manager.Options{
 		Port:    port,
 		CertDir: certDir,
+		Scheme:  genericscheme.Scheme,
+		// Only FederatedTypeConfigs in the KubeFed system namespace
+		// are read.
+		Namespace: kubefedNamespace,
 	})
 	if err != nil {
 		klog.Fatalf("error setting up webhook manager: %s", err)
@@ -82,6 +91,11 @@ func Run(stopChan <-chan struct{}) error {
 	hookServer.Register("/validate-kubefedcluster", &ctrwebhook.Admission{Handler: &kubefedcluster.KubeFedClusterAdmissionHook{}})
 	hookServer.Register("/validate-kubefedconfig", &ctrwebhook.Admission{Handler: &kubefedconfig.KubeFedConfigValidator{}})
 	hookServer.Register("/default-kubefedconfig", &ctrwebhook.Admission{Handler: &kubefedconfig.KubeFedConfigDefaulter{}})
+	hookServer.Register("/validate-federatedresource", &ctrwebhook.Admission{Handler: &federatedresource.FederatedResourceAdmissionHook{
+		Client:           mgr.GetClient(),
+		APIReader:        mgr.GetAPIReader(),
+		KubeFedNamespace: kubefedNamespace,
+	}})
 
 	hookServer.WebhookMux.Handle("/readyz/", http.StripPrefix("/readyz/", &healthz.Handler{}))
 
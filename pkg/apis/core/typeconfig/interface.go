@@ -18,6 +18,8 @@ package typeconfig
 
 import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"sigs.k8s.io/kubefed/pkg/apis/core/v1beta1"
 )
 
 // Interface defines how to interact with a FederatedTypeConfig
@@ -30,5 +32,11 @@ type Interface interface {
 	GetStatusType() *metav1.APIResource
 	GetStatusEnabled() bool
 	GetFederatedNamespaced() bool
+	GetOperationTimeout() *metav1.Duration
+	GetMaxInFlightOperations() *int64
+	GetServerSideApplyEnabled() bool
+	GetReconcileMode() v1beta1.ReconcileMode
+	GetRetainedFields() []v1beta1.RetainedField
+	GetInterpreter() *v1beta1.InterpreterConfig
 	IsNamespace() bool
 }
@@ -36,6 +36,10 @@ type FederatedServiceStatus struct {
 
 	// +optional
 	ClusterStatus []FederatedServiceClusterStatus `json:"clusterStatus,omitempty"`
+	// The status aggregated from the status of the resource in all
+	// clusters.
+	// +optional
+	AggregatedStatus *corev1.ServiceStatus `json:"aggregatedStatus,omitempty"`
 }
 
 // +kubebuilder:object:root=true
@@ -41,6 +41,18 @@ type ClusterObjectVersion struct {
 	// The last version produced for the resource by a KubeFed
 	// operation.
 	Version string `json:"version"`
+	// The version of the template the version was produced from.
+	// Versions recorded before the template version was tracked per
+	// cluster are assumed to have been produced from the template
+	// version of the status.
+	// +optional
+	TemplateVersion string `json:"templateVersion,omitempty"`
+	// The version of the overrides for the cluster the version was
+	// produced from. Versions recorded before the override version
+	// was tracked per cluster are assumed to have been produced from
+	// the override version of the status.
+	// +optional
+	OverrideVersion string `json:"overridesVersion,omitempty"`
 }
 
 // +kubebuilder:object:root=true
@@ -21,6 +21,7 @@ limitations under the License.
 package v1alpha1
 
 import (
+	"k8s.io/api/core/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
@@ -140,6 +141,11 @@ func (in *FederatedServiceStatus) DeepCopyInto(out *FederatedServiceStatus) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.AggregatedStatus != nil {
+		in, out := &in.AggregatedStatus, &out.AggregatedStatus
+		*out = new(v1.ServiceStatus)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FederatedServiceStatus.
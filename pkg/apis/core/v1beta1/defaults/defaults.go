@@ -42,7 +42,12 @@ const (
 	DefaultClusterHealthCheckTimeout          = 3 * time.Second
 
 	DefaultSyncControllerMaxConcurrentReconciles   = 1
+	DefaultSyncControllerOperationTimeout          = 30 * time.Second
+	DefaultSyncControllerMaxInFlightOperations     = 0
 	DefaultStatusControllerMaxConcurrentReconciles = 1
+
+	DefaultClusterRateLimitQPS   = 20
+	DefaultClusterRateLimitBurst = 30
 )
 
 func SetDefaultKubeFedConfig(fedConfig *v1beta1.KubeFedConfig) {
@@ -93,6 +98,8 @@ func SetDefaultKubeFedConfig(fedConfig *v1beta1.KubeFedConfig) {
 	}
 
 	setInt64(&spec.SyncController.MaxConcurrentReconciles, DefaultSyncControllerMaxConcurrentReconciles)
+	setDuration(&spec.SyncController.OperationTimeout, DefaultSyncControllerOperationTimeout)
+	setInt64(&spec.SyncController.MaxInFlightOperations, DefaultSyncControllerMaxInFlightOperations)
 
 	if spec.SyncController.AdoptResources == nil {
 		spec.SyncController.AdoptResources = new(v1beta1.ResourceAdoption)
@@ -104,6 +111,13 @@ func SetDefaultKubeFedConfig(fedConfig *v1beta1.KubeFedConfig) {
 	}
 
 	setInt64(&spec.StatusController.MaxConcurrentReconciles, DefaultStatusControllerMaxConcurrentReconciles)
+
+	if spec.ClusterRateLimit == nil {
+		spec.ClusterRateLimit = &v1beta1.ClusterRateLimitConfig{}
+	}
+
+	setInt64(&spec.ClusterRateLimit.QPS, DefaultClusterRateLimitQPS)
+	setInt64(&spec.ClusterRateLimit.Burst, DefaultClusterRateLimitBurst)
 }
 
 func setDefaultKubeFedFeatureGates(fgc []v1beta1.FeatureGatesConfig) []v1beta1.FeatureGatesConfig {
@@ -133,6 +133,19 @@ func TestSetDefaultKubeFedConfig(t *testing.T) {
 	SetDefaultKubeFedConfig(modifiedSyncControllerMaxConcurrentReconcilesKFC)
 	successCases["spec.syncController.maxConcurrentReconciles is preserved"] = KubeFedConfigComparison{syncControllerMaxConcurrentReconcilesKFC, modifiedSyncControllerMaxConcurrentReconcilesKFC}
 
+	syncControllerOperationTimeoutKFC := defaultKubeFedConfig()
+	syncControllerOperationTimeoutKFC.Spec.SyncController.OperationTimeout.Duration = DefaultSyncControllerOperationTimeout + 30*time.Second
+	modifiedSyncControllerOperationTimeoutKFC := syncControllerOperationTimeoutKFC.DeepCopyObject().(*v1beta1.KubeFedConfig)
+	SetDefaultKubeFedConfig(modifiedSyncControllerOperationTimeoutKFC)
+	successCases["spec.syncController.operationTimeout is preserved"] = KubeFedConfigComparison{syncControllerOperationTimeoutKFC, modifiedSyncControllerOperationTimeoutKFC}
+
+	syncControllerMaxInFlightOperationsKFC := defaultKubeFedConfig()
+	syncControllerMaxInFlightOperations := int64(DefaultSyncControllerMaxInFlightOperations + 5)
+	syncControllerMaxInFlightOperationsKFC.Spec.SyncController.MaxInFlightOperations = &syncControllerMaxInFlightOperations
+	modifiedSyncControllerMaxInFlightOperationsKFC := syncControllerMaxInFlightOperationsKFC.DeepCopyObject().(*v1beta1.KubeFedConfig)
+	SetDefaultKubeFedConfig(modifiedSyncControllerMaxInFlightOperationsKFC)
+	successCases["spec.syncController.maxInFlightOperations is preserved"] = KubeFedConfigComparison{syncControllerMaxInFlightOperationsKFC, modifiedSyncControllerMaxInFlightOperationsKFC}
+
 	adoptResourcesKFC := defaultKubeFedConfig()
 	*adoptResourcesKFC.Spec.SyncController.AdoptResources = v1beta1.AdoptResourcesDisabled
 	modifiedAdoptResourcesKFC := adoptResourcesKFC.DeepCopyObject().(*v1beta1.KubeFedConfig)
@@ -147,6 +160,16 @@ func TestSetDefaultKubeFedConfig(t *testing.T) {
 	SetDefaultKubeFedConfig(modifiedStatusControllerMaxConcurrentReconcilesKFC)
 	successCases["spec.statusController.maxConcurrentReconciles is preserved"] = KubeFedConfigComparison{statusControllerMaxConcurrentReconcilesKFC, modifiedStatusControllerMaxConcurrentReconcilesKFC}
 
+	// ClusterRateLimit
+	clusterRateLimitKFC := defaultKubeFedConfig()
+	clusterRateLimitQPS := int64(DefaultClusterRateLimitQPS + 10)
+	clusterRateLimitBurst := int64(DefaultClusterRateLimitBurst + 10)
+	clusterRateLimitKFC.Spec.ClusterRateLimit.QPS = &clusterRateLimitQPS
+	clusterRateLimitKFC.Spec.ClusterRateLimit.Burst = &clusterRateLimitBurst
+	modifiedClusterRateLimitKFC := clusterRateLimitKFC.DeepCopyObject().(*v1beta1.KubeFedConfig)
+	SetDefaultKubeFedConfig(modifiedClusterRateLimitKFC)
+	successCases["spec.clusterRateLimit is preserved"] = KubeFedConfigComparison{clusterRateLimitKFC, modifiedClusterRateLimitKFC}
+
 	for k, v := range successCases {
 		if !reflect.DeepEqual(v.original, v.modified) {
 			t.Errorf("[%s] expected success: original=%+v, modified=%+v", k, *v.original, *v.modified)
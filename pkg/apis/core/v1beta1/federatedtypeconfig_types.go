@@ -47,6 +47,116 @@ type FederatedTypeConfigSpec struct {
 	// Whether or not Status object should be populated.
 	// +optional
 	StatusCollection *StatusCollectionMode `json:"statusCollection,omitempty"`
+	// Configuration of the operations dispatched to member clusters
+	// for resources of the type. Settings that are not provided
+	// default to those of the sync controller in the KubeFedConfig.
+	// +optional
+	Dispatch *DispatchConfig `json:"dispatch,omitempty"`
+	// Fields whose values are retained from resources in member
+	// clusters when the resources are updated. The fields are retained
+	// in addition to those retained for all resources of certain
+	// types (e.g. the clusterIP of a service).
+	// +optional
+	RetainedFields []RetainedField `json:"retainedFields,omitempty"`
+	// Configuration of how KubeFed interprets resources of the target
+	// type, e.g. which fields are retained from resources in member
+	// clusters and how their health and status are determined. The
+	// built-in interpretation for the target type is used if not
+	// provided.
+	// +optional
+	Interpreter *InterpreterConfig `json:"interpreter,omitempty"`
+}
+
+// InterpreterConfig defines how resources of a target type are
+// interpreted.
+type InterpreterConfig struct {
+	// Webhook that interprets resources of the target type for the
+	// given operations.
+	// +optional
+	Webhook *InterpreterWebhook `json:"webhook,omitempty"`
+}
+
+// InterpreterWebhook defines a webhook that interprets resources of a
+// target type.
+type InterpreterWebhook struct {
+	// The https URL requests are posted to.
+	URL string `json:"url"`
+	// PEM encoded CA bundle used to verify the serving certificate of
+	// the webhook. The system trust roots are used if not provided.
+	// +optional
+	CABundle []byte `json:"caBundle,omitempty"`
+	// Seconds to wait for a response from the webhook. Must be
+	// between 1 and 30. Defaults to 10.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=30
+	// +optional
+	TimeoutSeconds *int32 `json:"timeoutSeconds,omitempty"`
+	// Operations that are interpreted by the webhook. Operations that
+	// are not listed use the built-in interpretation.
+	// +kubebuilder:validation:MinItems=1
+	Operations []InterpreterOperation `json:"operations"`
+}
+
+// InterpreterOperation identifies an operation of an interpreter.
+type InterpreterOperation string
+
+const (
+	InterpretRetain          InterpreterOperation = "Retain"
+	InterpretHealth          InterpreterOperation = "Health"
+	InterpretGetReplicas     InterpreterOperation = "GetReplicas"
+	InterpretSetReplicas     InterpreterOperation = "SetReplicas"
+	InterpretReflectStatus   InterpreterOperation = "ReflectStatus"
+	InterpretAggregateStatus InterpreterOperation = "AggregateStatus"
+)
+
+// RetainedField identifies a field whose value is retained from
+// resources in member clusters.
+type RetainedField struct {
+	// JSON pointer to the field (e.g. /spec/host). The path may only
+	// traverse objects, and may not target the kind, api version or
+	// metadata other than labels and annotations.
+	Path string `json:"path"`
+	// When the value of the field is retained. "Always" retains the
+	// value even if the field is set in the desired resource, and
+	// "IfUnset" retains the value only if the field is not set in the
+	// desired resource. Defaults to "Always".
+	// +optional
+	Mode RetentionMode `json:"mode,omitempty"`
+}
+
+// RetentionMode defines when the value of a field is retained.
+type RetentionMode string
+
+const (
+	RetainAlways  RetentionMode = "Always"
+	RetainIfUnset RetentionMode = "IfUnset"
+)
+
+// DispatchConfig defines how operations are dispatched to member
+// clusters.
+type DispatchConfig struct {
+	// Time to wait for the operations dispatched to member clusters
+	// for a federated resource to complete.
+	// +optional
+	OperationTimeout *metav1.Duration `json:"operationTimeout,omitempty"`
+	// The maximum number of operations on member clusters that may be
+	// in flight at once for a federated resource. 0 does not limit
+	// the number of operations.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	MaxInFlightOperations *int64 `json:"maxInFlightOperations,omitempty"`
+	// Whether resources are created and updated in member clusters
+	// with server-side apply rather than replaced by full updates.
+	// Defaults to "Disabled".
+	// +optional
+	ServerSideApply *ServerSideApplyMode `json:"serverSideApply,omitempty"`
+	// Whether resources in member clusters that differ from the
+	// desired state are updated ("Enforce") or the differences are
+	// only reported in the status of federated resources
+	// ("ReportOnly"). Can be overridden for a federated resource with
+	// the kubefed.io/reconcile-mode annotation. Defaults to "Enforce".
+	// +optional
+	ReconcileMode *ReconcileMode `json:"reconcileMode,omitempty"`
 }
 
 // APIResource defines how to configure the dynamic client for an API resource.
@@ -85,6 +195,24 @@ const (
 	StatusCollectionDisabled StatusCollectionMode = "Disabled"
 )
 
+// ServerSideApplyMode defines whether server-side apply is used to
+// propagate resources to member clusters.
+type ServerSideApplyMode string
+
+const (
+	ServerSideApplyEnabled  ServerSideApplyMode = "Enabled"
+	ServerSideApplyDisabled ServerSideApplyMode = "Disabled"
+)
+
+// ReconcileMode defines whether resources in member clusters are
+// updated to match the desired state.
+type ReconcileMode string
+
+const (
+	ReconcileEnforce    ReconcileMode = "Enforce"
+	ReconcileReportOnly ReconcileMode = "ReportOnly"
+)
+
 // ControllerStatus defines the current state of the controller
 type ControllerStatus string
 
@@ -240,10 +368,58 @@ func (f *FederatedTypeConfig) GetFederatedNamespaced() bool {
 	return f.GetNamespaced()
 }
 
+func (f *FederatedTypeConfig) GetOperationTimeout() *metav1.Duration {
+	if f.Spec.Dispatch == nil {
+		return nil
+	}
+	return f.Spec.Dispatch.OperationTimeout
+}
+
+func (f *FederatedTypeConfig) GetMaxInFlightOperations() *int64 {
+	if f.Spec.Dispatch == nil {
+		return nil
+	}
+	return f.Spec.Dispatch.MaxInFlightOperations
+}
+
+func (f *FederatedTypeConfig) GetServerSideApplyEnabled() bool {
+	return f.Spec.Dispatch != nil && f.Spec.Dispatch.ServerSideApply != nil &&
+		*f.Spec.Dispatch.ServerSideApply == ServerSideApplyEnabled
+}
+
+func (f *FederatedTypeConfig) GetReconcileMode() ReconcileMode {
+	if f.Spec.Dispatch == nil || f.Spec.Dispatch.ReconcileMode == nil {
+		return ReconcileEnforce
+	}
+	return *f.Spec.Dispatch.ReconcileMode
+}
+
+func (f *FederatedTypeConfig) GetRetainedFields() []RetainedField {
+	return f.Spec.RetainedFields
+}
+
+func (f *FederatedTypeConfig) GetInterpreter() *InterpreterConfig {
+	return f.Spec.Interpreter
+}
+
 func (f *FederatedTypeConfig) IsNamespace() bool {
 	return f.Name == common.NamespaceName
 }
 
+// Fields returns the names of the nested fields identified by the path
+// of the retained field, or nil if the path is not a JSON pointer.
+func (f *RetainedField) Fields() []string {
+	if !strings.HasPrefix(f.Path, "/") {
+		return nil
+	}
+	fields := strings.Split(f.Path[1:], "/")
+	for i, field := range fields {
+		field = strings.ReplaceAll(field, "~1", "/")
+		fields[i] = strings.ReplaceAll(field, "~0", "~")
+	}
+	return fields
+}
+
 func (a *APIResource) Namespaced() bool {
 	return a.Scope == apiextv1.NamespaceScoped
 }
@@ -55,6 +55,39 @@ type KubeFedClusterSpec struct {
 	// ProxyURL allows to set proxy URL for the cluster.
 	// +optional
 	ProxyURL string `json:"proxyURL"`
+
+	// Taints prevent federated resources from being propagated to
+	// the cluster unless their placement tolerates the taint. A
+	// NoSchedule taint prevents new propagation to the cluster, and
+	// a NoExecute taint additionally removes resources that have
+	// already been propagated.
+	// +optional
+	Taints []apiv1.Taint `json:"taints,omitempty"`
+
+	// Unschedulable (cordoned) prevents federated resources from
+	// being propagated to the cluster unless they have already been
+	// propagated to it.
+	// +optional
+	Unschedulable bool `json:"unschedulable,omitempty"`
+
+	// Drain indicates that federated resources placed by cluster
+	// selector and replicas scheduled by a
+	// ReplicaSchedulingPreference should be moved to other clusters.
+	// A cluster that is being drained is also unschedulable.
+	// +optional
+	Drain bool `json:"drain,omitempty"`
+
+	// QPS is the maximum number of queries per second that a client
+	// of the control plane sends to the API of the member cluster.
+	// Defaults to the clusterRateLimit of the KubeFedConfig.
+	// +optional
+	QPS *int64 `json:"qps,omitempty"`
+
+	// Burst is the maximum number of queries that a client of the
+	// control plane sends to the API of the member cluster at once.
+	// Defaults to the clusterRateLimit of the KubeFedConfig.
+	// +optional
+	Burst *int64 `json:"burst,omitempty"`
 }
 
 // LocalSecretReference is a reference to a secret within the enclosing
@@ -39,6 +39,8 @@ type KubeFedConfigSpec struct {
 	SyncController *SyncControllerConfig `json:"syncController,omitempty"`
 	// +optional
 	StatusController *StatusControllerConfig `json:"statusController,omitempty"`
+	// +optional
+	ClusterRateLimit *ClusterRateLimitConfig `json:"clusterRateLimit,omitempty"`
 }
 
 type DurationConfig struct {
@@ -118,6 +120,15 @@ type SyncControllerConfig struct {
 	// "Enabled".
 	// +optional
 	AdoptResources *ResourceAdoption `json:"adoptResources,omitempty"`
+	// Time to wait for the operations dispatched to member clusters
+	// for a federated resource to complete. Defaults to 30s.
+	// +optional
+	OperationTimeout *metav1.Duration `json:"operationTimeout,omitempty"`
+	// The maximum number of operations on member clusters that may be
+	// in flight at once for a federated resource. Defaults to 0, which
+	// does not limit the number of operations.
+	// +optional
+	MaxInFlightOperations *int64 `json:"maxInFlightOperations,omitempty"`
 }
 
 type ResourceAdoption string
@@ -134,6 +145,19 @@ type StatusControllerConfig struct {
 	MaxConcurrentReconciles *int64 `json:"maxConcurrentReconciles,omitempty"`
 }
 
+// ClusterRateLimitConfig defines the rate limit of the clients of
+// member clusters for clusters that do not specify their own.
+type ClusterRateLimitConfig struct {
+	// The maximum number of queries per second to the API of a member
+	// cluster. Defaults to 20.
+	// +optional
+	QPS *int64 `json:"qps,omitempty"`
+	// The maximum number of queries to the API of a member cluster at
+	// once. Defaults to 30.
+	// +optional
+	Burst *int64 `json:"burst,omitempty"`
+}
+
 // +kubebuilder:object:root=true
 // +kubebuilder:resource:path=kubefedconfigs
 
@@ -28,6 +28,7 @@ import (
 	apimachineryval "k8s.io/apimachinery/pkg/api/validation"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/sets"
 	valutil "k8s.io/apimachinery/pkg/util/validation"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 	"k8s.io/client-go/rest"
@@ -72,9 +73,105 @@ func ValidateFederatedTypeConfigSpec(spec *v1beta1.FederatedTypeConfigSpec, fldP
 		allErrs = append(allErrs, validateEnumStrings(fldPath.Child("statusCollection"), string(*spec.StatusCollection), []string{string(v1beta1.StatusCollectionEnabled), string(v1beta1.StatusCollectionDisabled)})...)
 	}
 
+	if dispatch := spec.Dispatch; dispatch != nil {
+		dispatchPath := fldPath.Child("dispatch")
+		if dispatch.OperationTimeout != nil {
+			allErrs = append(allErrs, validateDurationGreaterThan0(dispatchPath.Child("operationTimeout"), dispatch.OperationTimeout)...)
+		}
+		if dispatch.MaxInFlightOperations != nil {
+			allErrs = append(allErrs, validateIntPtrNotNegative(dispatchPath.Child("maxInFlightOperations"), dispatch.MaxInFlightOperations)...)
+		}
+		if dispatch.ServerSideApply != nil {
+			allErrs = append(allErrs, validateEnumStrings(dispatchPath.Child("serverSideApply"), string(*dispatch.ServerSideApply), []string{string(v1beta1.ServerSideApplyEnabled), string(v1beta1.ServerSideApplyDisabled)})...)
+		}
+		if dispatch.ReconcileMode != nil {
+			allErrs = append(allErrs, validateEnumStrings(dispatchPath.Child("reconcileMode"), string(*dispatch.ReconcileMode), []string{string(v1beta1.ReconcileEnforce), string(v1beta1.ReconcileReportOnly)})...)
+		}
+	}
+
+	allErrs = append(allErrs, validateRetainedFields(spec.RetainedFields, fldPath.Child("retainedFields"))...)
+
+	if spec.Interpreter != nil && spec.Interpreter.Webhook != nil {
+		allErrs = append(allErrs, validateInterpreterWebhook(spec.Interpreter.Webhook, fldPath.Child("interpreter", "webhook"))...)
+	}
+
 	return allErrs
 }
 
+func validateInterpreterWebhook(webhook *v1beta1.InterpreterWebhook, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	urlPath := fldPath.Child("url")
+	if webhook.URL == "" {
+		allErrs = append(allErrs, field.Required(urlPath, ""))
+	} else if u, err := url.Parse(webhook.URL); err != nil || u.Scheme != "https" || u.Host == "" {
+		allErrs = append(allErrs, field.Invalid(urlPath, webhook.URL, "must be an absolute https URL"))
+	}
+
+	if webhook.TimeoutSeconds != nil && (*webhook.TimeoutSeconds < 1 || *webhook.TimeoutSeconds > 30) {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("timeoutSeconds"), *webhook.TimeoutSeconds, "must be between 1 and 30"))
+	}
+
+	operationsPath := fldPath.Child("operations")
+	if len(webhook.Operations) == 0 {
+		allErrs = append(allErrs, field.Required(operationsPath, ""))
+	}
+	accepted := []string{
+		string(v1beta1.InterpretRetain),
+		string(v1beta1.InterpretHealth),
+		string(v1beta1.InterpretGetReplicas),
+		string(v1beta1.InterpretSetReplicas),
+		string(v1beta1.InterpretReflectStatus),
+		string(v1beta1.InterpretAggregateStatus),
+	}
+	operations := sets.NewString()
+	for i, operation := range webhook.Operations {
+		allErrs = append(allErrs, validateEnumStrings(operationsPath.Index(i), string(operation), accepted)...)
+		if operations.Has(string(operation)) {
+			allErrs = append(allErrs, field.Duplicate(operationsPath.Index(i), operation))
+		}
+		operations.Insert(string(operation))
+	}
+	return allErrs
+}
+
+func validateRetainedFields(retainedFields []v1beta1.RetainedField, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	paths := sets.NewString()
+	for i := range retainedFields {
+		retainedField := &retainedFields[i]
+		path := fldPath.Index(i).Child("path")
+		fields := retainedField.Fields()
+		switch {
+		case retainedField.Path == "":
+			allErrs = append(allErrs, field.Required(path, ""))
+		case fields == nil:
+			allErrs = append(allErrs, field.Invalid(path, retainedField.Path, "must be a JSON pointer"))
+		case sets.NewString(fields...).Has(""):
+			allErrs = append(allErrs, field.Invalid(path, retainedField.Path, "must not contain empty field names"))
+		case !retainableFields(fields):
+			allErrs = append(allErrs, field.Invalid(path, retainedField.Path, "must not target the kind, api version or metadata other than labels and annotations"))
+		case paths.Has(retainedField.Path):
+			allErrs = append(allErrs, field.Duplicate(path, retainedField.Path))
+		}
+		paths.Insert(retainedField.Path)
+
+		if retainedField.Mode != "" {
+			allErrs = append(allErrs, validateEnumStrings(fldPath.Index(i).Child("mode"), string(retainedField.Mode), []string{string(v1beta1.RetainAlways), string(v1beta1.RetainIfUnset)})...)
+		}
+	}
+	return allErrs
+}
+
+func retainableFields(fields []string) bool {
+	switch fields[0] {
+	case "kind", "apiVersion":
+		return false
+	case "metadata":
+		return len(fields) > 2 && (fields[1] == "labels" || fields[1] == "annotations")
+	}
+	return true
+}
+
 const domainWithAtLeastOneDot string = "should be a domain with at least one dot"
 
 func ValidateFederatedAPIResource(fedType *v1beta1.APIResource, fldPath *field.Path) field.ErrorList {
@@ -167,6 +264,13 @@ func validateKubeFedClusterSpec(spec *v1beta1.KubeFedClusterSpec, path *field.Pa
 	if spec.ProxyURL != "" {
 		allErrs = append(allErrs, validateProxyURL(spec.ProxyURL, path.Child("proxyURL"))...)
 	}
+	allErrs = append(allErrs, validateTaints(spec.Taints, path.Child("taints"))...)
+	if spec.QPS != nil {
+		allErrs = append(allErrs, validateGreaterThan0(path.Child("qps"), *spec.QPS)...)
+	}
+	if spec.Burst != nil {
+		allErrs = append(allErrs, validateGreaterThan0(path.Child("burst"), *spec.Burst)...)
+	}
 	return allErrs
 }
 
@@ -252,6 +356,35 @@ func validateDisabledTLSValidations(disabledTLSValidations []v1beta1.TLSValidati
 	return allErrs
 }
 
+func validateTaints(taints []corev1.Taint, path *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	uniqueTaints := make(map[corev1.TaintEffect]sets.String)
+	for i, taint := range taints {
+		idxPath := path.Index(i)
+		for _, msg := range valutil.IsQualifiedName(taint.Key) {
+			allErrs = append(allErrs, field.Invalid(idxPath.Child("key"), taint.Key, msg))
+		}
+		if taint.Value != "" {
+			for _, msg := range valutil.IsValidLabelValue(taint.Value) {
+				allErrs = append(allErrs, field.Invalid(idxPath.Child("value"), taint.Value, msg))
+			}
+		}
+		allErrs = append(allErrs, validateEnumStrings(idxPath.Child("effect"), string(taint.Effect),
+			[]string{string(corev1.TaintEffectNoSchedule), string(corev1.TaintEffectPreferNoSchedule), string(corev1.TaintEffectNoExecute)})...)
+
+		// A taint is uniquely identified by its key and effect.
+		if uniqueTaints[taint.Effect] == nil {
+			uniqueTaints[taint.Effect] = sets.NewString()
+		}
+		if uniqueTaints[taint.Effect].Has(taint.Key) {
+			allErrs = append(allErrs, field.Duplicate(idxPath, fmt.Sprintf("%s:%s", taint.Key, taint.Effect)))
+		}
+		uniqueTaints[taint.Effect].Insert(taint.Key)
+	}
+	return allErrs
+}
+
 func validateClusterCondition(cc *v1beta1.ClusterCondition, path *field.Path) field.ErrorList {
 	var allErrs field.ErrorList
 
@@ -358,6 +491,8 @@ func ValidateKubeFedConfig(kubeFedConfig, oldKubeFedConfig *v1beta1.KubeFedConfi
 		allErrs = append(allErrs, field.Required(adoptPath, ""))
 	default:
 		allErrs = append(allErrs, validateIntPtrGreaterThan0(syncPath.Child("maxConcurrentReconciles"), sync.MaxConcurrentReconciles)...)
+		allErrs = append(allErrs, validateDurationGreaterThan0(syncPath.Child("operationTimeout"), sync.OperationTimeout)...)
+		allErrs = append(allErrs, validateIntPtrNotNegative(syncPath.Child("maxInFlightOperations"), sync.MaxInFlightOperations)...)
 		allErrs = append(allErrs, validateEnumStrings(adoptPath, string(*sync.AdoptResources),
 			[]string{string(v1beta1.AdoptResourcesEnabled), string(v1beta1.AdoptResourcesDisabled)})...)
 	}
@@ -370,6 +505,15 @@ func ValidateKubeFedConfig(kubeFedConfig, oldKubeFedConfig *v1beta1.KubeFedConfi
 		allErrs = append(allErrs, validateIntPtrGreaterThan0(statusControllerPath.Child("maxConcurrentReconciles"), statusController.MaxConcurrentReconciles)...)
 	}
 
+	rateLimit := spec.ClusterRateLimit
+	rateLimitPath := specPath.Child("clusterRateLimit")
+	if rateLimit == nil {
+		allErrs = append(allErrs, field.Required(rateLimitPath, ""))
+	} else {
+		allErrs = append(allErrs, validateIntPtrGreaterThan0(rateLimitPath.Child("qps"), rateLimit.QPS)...)
+		allErrs = append(allErrs, validateIntPtrGreaterThan0(rateLimitPath.Child("burst"), rateLimit.Burst)...)
+	}
+
 	return allErrs
 }
 
@@ -393,6 +537,16 @@ func validateIntPtrGreaterThan0(path *field.Path, value *int64) field.ErrorList
 	return errs
 }
 
+func validateIntPtrNotNegative(path *field.Path, value *int64) field.ErrorList {
+	errs := field.ErrorList{}
+	if value == nil {
+		errs = append(errs, field.Required(path, ""))
+	} else if *value < 0 {
+		errs = append(errs, field.Invalid(path, *value, "should be greater than or equal to 0"))
+	}
+	return errs
+}
+
 func validateGreaterThan0(path *field.Path, value int64) field.ErrorList {
 	errs := field.ErrorList{}
 	if value <= 0 {
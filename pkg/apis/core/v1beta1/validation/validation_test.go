@@ -120,6 +120,85 @@ func TestValidateFederatedTypeConfigSpec(t *testing.T) {
 	invalidStatusCollection.Spec.StatusCollection = &invalidStatusCollectionMode
 	errorCases["spec.statusCollection: Unsupported value"] = invalidStatusCollection
 
+	invalidOperationTimeout := validFederatedTypeConfig()
+	invalidOperationTimeout.Spec.Dispatch = &v1beta1.DispatchConfig{
+		OperationTimeout: &metav1.Duration{},
+	}
+	errorCases["spec.dispatch.operationTimeout: Invalid value"] = invalidOperationTimeout
+
+	invalidMaxInFlightOperations := validFederatedTypeConfig()
+	negativeMaxInFlightOperations := int64(-1)
+	invalidMaxInFlightOperations.Spec.Dispatch = &v1beta1.DispatchConfig{
+		MaxInFlightOperations: &negativeMaxInFlightOperations,
+	}
+	errorCases["spec.dispatch.maxInFlightOperations: Invalid value"] = invalidMaxInFlightOperations
+
+	invalidServerSideApply := validFederatedTypeConfig()
+	invalidServerSideApplyMode := v1beta1.ServerSideApplyMode("InvalidServerSideApplyMode")
+	invalidServerSideApply.Spec.Dispatch = &v1beta1.DispatchConfig{
+		ServerSideApply: &invalidServerSideApplyMode,
+	}
+	errorCases["spec.dispatch.serverSideApply: Unsupported value"] = invalidServerSideApply
+
+	invalidReconcileMode := validFederatedTypeConfig()
+	invalidReconcileModeValue := v1beta1.ReconcileMode("InvalidReconcileMode")
+	invalidReconcileMode.Spec.Dispatch = &v1beta1.DispatchConfig{
+		ReconcileMode: &invalidReconcileModeValue,
+	}
+	errorCases["spec.dispatch.reconcileMode: Unsupported value"] = invalidReconcileMode
+
+	invalidRetainedFieldPath := validFederatedTypeConfig()
+	invalidRetainedFieldPath.Spec.RetainedFields = []v1beta1.RetainedField{{Path: "spec.host"}}
+	errorCases["spec.retainedFields[0].path: Invalid value: \"spec.host\": must be a JSON pointer"] = invalidRetainedFieldPath
+
+	invalidRetainedFieldMetadata := validFederatedTypeConfig()
+	invalidRetainedFieldMetadata.Spec.RetainedFields = []v1beta1.RetainedField{{Path: "/metadata/name"}}
+	errorCases["spec.retainedFields[0].path: Invalid value: \"/metadata/name\": must not target"] = invalidRetainedFieldMetadata
+
+	duplicateRetainedField := validFederatedTypeConfig()
+	duplicateRetainedField.Spec.RetainedFields = []v1beta1.RetainedField{{Path: "/spec/host"}, {Path: "/spec/host"}}
+	errorCases["spec.retainedFields[1].path: Duplicate value"] = duplicateRetainedField
+
+	invalidRetentionMode := validFederatedTypeConfig()
+	invalidRetentionMode.Spec.RetainedFields = []v1beta1.RetainedField{{Path: "/spec/host", Mode: "Sometimes"}}
+	errorCases["spec.retainedFields[0].mode: Unsupported value"] = invalidRetentionMode
+
+	invalidInterpreterURL := validFederatedTypeConfig()
+	invalidInterpreterURL.Spec.Interpreter = &v1beta1.InterpreterConfig{Webhook: &v1beta1.InterpreterWebhook{
+		URL:        "http://interpreter.example.com",
+		Operations: []v1beta1.InterpreterOperation{v1beta1.InterpretHealth},
+	}}
+	errorCases["spec.interpreter.webhook.url: Invalid value"] = invalidInterpreterURL
+
+	invalidTimeoutSeconds := int32(60)
+	invalidInterpreterTimeout := validFederatedTypeConfig()
+	invalidInterpreterTimeout.Spec.Interpreter = &v1beta1.InterpreterConfig{Webhook: &v1beta1.InterpreterWebhook{
+		URL:            "https://interpreter.example.com",
+		TimeoutSeconds: &invalidTimeoutSeconds,
+		Operations:     []v1beta1.InterpreterOperation{v1beta1.InterpretHealth},
+	}}
+	errorCases["spec.interpreter.webhook.timeoutSeconds: Invalid value"] = invalidInterpreterTimeout
+
+	missingInterpreterOperations := validFederatedTypeConfig()
+	missingInterpreterOperations.Spec.Interpreter = &v1beta1.InterpreterConfig{Webhook: &v1beta1.InterpreterWebhook{
+		URL: "https://interpreter.example.com",
+	}}
+	errorCases["spec.interpreter.webhook.operations: Required value"] = missingInterpreterOperations
+
+	invalidInterpreterOperation := validFederatedTypeConfig()
+	invalidInterpreterOperation.Spec.Interpreter = &v1beta1.InterpreterConfig{Webhook: &v1beta1.InterpreterWebhook{
+		URL:        "https://interpreter.example.com",
+		Operations: []v1beta1.InterpreterOperation{"Delete"},
+	}}
+	errorCases["spec.interpreter.webhook.operations[0]: Unsupported value"] = invalidInterpreterOperation
+
+	duplicateInterpreterOperation := validFederatedTypeConfig()
+	duplicateInterpreterOperation.Spec.Interpreter = &v1beta1.InterpreterConfig{Webhook: &v1beta1.InterpreterWebhook{
+		URL:        "https://interpreter.example.com",
+		Operations: []v1beta1.InterpreterOperation{v1beta1.InterpretHealth, v1beta1.InterpretHealth},
+	}}
+	errorCases["spec.interpreter.webhook.operations[1]: Duplicate value"] = duplicateInterpreterOperation
+
 	for k, v := range errorCases {
 		errs := ValidateFederatedTypeConfigSpec(&v.Spec, field.NewPath("spec"))
 		if len(errs) == 0 {
@@ -359,6 +438,14 @@ func TestValidateKubeFedCluster(t *testing.T) {
 		false,
 	}
 
+	invalidKFCQPS := testcommon.ValidKubeFedCluster()
+	zeroQPS := int64(0)
+	invalidKFCQPS.Spec.QPS = &zeroQPS
+	errorCases["qps: Invalid value"] = KFCAndStatusSubResource{
+		invalidKFCQPS,
+		false,
+	}
+
 	invalidKFCStatus := testcommon.ValidKubeFedCluster()
 	invalidKFCStatus.Status.Conditions[1].Type = ""
 	errorCases["conditions[1].type: Required value"] = KFCAndStatusSubResource{
@@ -616,6 +703,70 @@ func TestDisabledTLSValidations(t *testing.T) {
 	}
 }
 
+func TestValidateTaints(t *testing.T) {
+	testCases := []struct {
+		taints         []corev1.Taint
+		expectedErr    bool
+		expectedErrMsg string
+	}{
+		{
+			[]corev1.Taint{
+				{Key: "dedicated", Value: "gpu", Effect: corev1.TaintEffectNoSchedule},
+				{Key: "dedicated", Value: "gpu", Effect: corev1.TaintEffectNoExecute},
+				{Key: "example.com/maintenance", Effect: corev1.TaintEffectNoExecute},
+			},
+			false,
+			"",
+		},
+		{
+			[]corev1.Taint{
+				{Key: "invalid key", Effect: corev1.TaintEffectNoSchedule},
+			},
+			true,
+			"taints[0].key: Invalid value",
+		},
+		{
+			[]corev1.Taint{
+				{Key: "dedicated", Value: "invalid value", Effect: corev1.TaintEffectNoSchedule},
+			},
+			true,
+			"taints[0].value: Invalid value",
+		},
+		{
+			[]corev1.Taint{
+				{Key: "dedicated"},
+			},
+			true,
+			"taints[0].effect: Required value",
+		},
+		{
+			[]corev1.Taint{
+				{Key: "dedicated", Effect: "Invalid"},
+			},
+			true,
+			"taints[0].effect: Unsupported value",
+		},
+		{
+			[]corev1.Taint{
+				{Key: "dedicated", Value: "gpu", Effect: corev1.TaintEffectNoSchedule},
+				{Key: "dedicated", Value: "cpu", Effect: corev1.TaintEffectNoSchedule},
+			},
+			true,
+			"taints[1]: Duplicate value",
+		},
+	}
+
+	for _, test := range testCases {
+		errs := validateTaints(test.taints, field.NewPath("taints"))
+		hasErr := len(errs) > 0
+		if hasErr != test.expectedErr {
+			t.Errorf("[%s] expected failure: %t, got errors: %v", test.expectedErrMsg, test.expectedErr, errs)
+		} else if hasErr && !strings.Contains(errs[0].Error(), test.expectedErrMsg) {
+			t.Errorf("unexpected error: %v, expected: %q", errs[0].Error(), test.expectedErrMsg)
+		}
+	}
+}
+
 func TestValidateClusterCondition(t *testing.T) {
 	testCases := []struct {
 		cc             *v1beta1.ClusterCondition
@@ -860,6 +1011,23 @@ func TestValidateKubeFedConfig(t *testing.T) {
 	invalidSyncControllerMaxConcurrentReconcilesGreaterThan0.Spec.SyncController.MaxConcurrentReconciles = zeroIntPtr
 	errorCases["spec.syncController.maxConcurrentReconciles: Invalid value"] = invalidSyncControllerMaxConcurrentReconcilesGreaterThan0
 
+	invalidSyncControllerOperationTimeoutNil := testcommon.ValidKubeFedConfig()
+	invalidSyncControllerOperationTimeoutNil.Spec.SyncController.OperationTimeout = nil
+	errorCases["spec.syncController.operationTimeout: Required value"] = invalidSyncControllerOperationTimeoutNil
+
+	invalidSyncControllerOperationTimeoutGreaterThan0 := testcommon.ValidKubeFedConfig()
+	invalidSyncControllerOperationTimeoutGreaterThan0.Spec.SyncController.OperationTimeout.Duration = 0
+	errorCases["spec.syncController.operationTimeout: Invalid value"] = invalidSyncControllerOperationTimeoutGreaterThan0
+
+	invalidSyncControllerMaxInFlightOperationsNil := testcommon.ValidKubeFedConfig()
+	invalidSyncControllerMaxInFlightOperationsNil.Spec.SyncController.MaxInFlightOperations = nil
+	errorCases["spec.syncController.maxInFlightOperations: Required value"] = invalidSyncControllerMaxInFlightOperationsNil
+
+	invalidSyncControllerMaxInFlightOperationsNegative := testcommon.ValidKubeFedConfig()
+	negativeInt := int64(-1)
+	invalidSyncControllerMaxInFlightOperationsNegative.Spec.SyncController.MaxInFlightOperations = &negativeInt
+	errorCases["spec.syncController.maxInFlightOperations: Invalid value"] = invalidSyncControllerMaxInFlightOperationsNegative
+
 	invalidAdoptResourcesNil := testcommon.ValidKubeFedConfig()
 	invalidAdoptResourcesNil.Spec.SyncController.AdoptResources = nil
 	errorCases["spec.syncController.adoptResources: Required value"] = invalidAdoptResourcesNil
@@ -881,6 +1049,18 @@ func TestValidateKubeFedConfig(t *testing.T) {
 	invalidStatusControllerMaxConcurrentReconcilesGreaterThan0.Spec.StatusController.MaxConcurrentReconciles = zeroIntPtr
 	errorCases["spec.statusController.maxConcurrentReconciles: Invalid value"] = invalidStatusControllerMaxConcurrentReconcilesGreaterThan0
 
+	invalidClusterRateLimitNil := testcommon.ValidKubeFedConfig()
+	invalidClusterRateLimitNil.Spec.ClusterRateLimit = nil
+	errorCases["spec.clusterRateLimit: Required value"] = invalidClusterRateLimitNil
+
+	invalidClusterRateLimitQPSGreaterThan0 := testcommon.ValidKubeFedConfig()
+	invalidClusterRateLimitQPSGreaterThan0.Spec.ClusterRateLimit.QPS = zeroIntPtr
+	errorCases["spec.clusterRateLimit.qps: Invalid value"] = invalidClusterRateLimitQPSGreaterThan0
+
+	invalidClusterRateLimitBurstNil := testcommon.ValidKubeFedConfig()
+	invalidClusterRateLimitBurstNil.Spec.ClusterRateLimit.Burst = nil
+	errorCases["spec.clusterRateLimit.burst: Required value"] = invalidClusterRateLimitBurstNil
+
 	for k, v := range errorCases {
 		errs := ValidateKubeFedConfig(v, testcommon.ValidKubeFedConfig())
 		if len(errs) == 0 {
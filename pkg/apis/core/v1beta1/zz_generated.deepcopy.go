@@ -21,6 +21,7 @@ limitations under the License.
 package v1beta1
 
 import (
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
@@ -105,6 +106,66 @@ func (in *ClusterHealthCheckConfig) DeepCopy() *ClusterHealthCheckConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterRateLimitConfig) DeepCopyInto(out *ClusterRateLimitConfig) {
+	*out = *in
+	if in.QPS != nil {
+		in, out := &in.QPS, &out.QPS
+		*out = new(int64)
+		**out = **in
+	}
+	if in.Burst != nil {
+		in, out := &in.Burst, &out.Burst
+		*out = new(int64)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterRateLimitConfig.
+func (in *ClusterRateLimitConfig) DeepCopy() *ClusterRateLimitConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterRateLimitConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DispatchConfig) DeepCopyInto(out *DispatchConfig) {
+	*out = *in
+	if in.OperationTimeout != nil {
+		in, out := &in.OperationTimeout, &out.OperationTimeout
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.MaxInFlightOperations != nil {
+		in, out := &in.MaxInFlightOperations, &out.MaxInFlightOperations
+		*out = new(int64)
+		**out = **in
+	}
+	if in.ServerSideApply != nil {
+		in, out := &in.ServerSideApply, &out.ServerSideApply
+		*out = new(ServerSideApplyMode)
+		**out = **in
+	}
+	if in.ReconcileMode != nil {
+		in, out := &in.ReconcileMode, &out.ReconcileMode
+		*out = new(ReconcileMode)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DispatchConfig.
+func (in *DispatchConfig) DeepCopy() *DispatchConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(DispatchConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DurationConfig) DeepCopyInto(out *DurationConfig) {
 	*out = *in
@@ -224,6 +285,21 @@ func (in *FederatedTypeConfigSpec) DeepCopyInto(out *FederatedTypeConfigSpec) {
 		*out = new(StatusCollectionMode)
 		**out = **in
 	}
+	if in.Dispatch != nil {
+		in, out := &in.Dispatch, &out.Dispatch
+		*out = new(DispatchConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RetainedFields != nil {
+		in, out := &in.RetainedFields, &out.RetainedFields
+		*out = make([]RetainedField, len(*in))
+		copy(*out, *in)
+	}
+	if in.Interpreter != nil {
+		in, out := &in.Interpreter, &out.Interpreter
+		*out = new(InterpreterConfig)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FederatedTypeConfigSpec.
@@ -256,6 +332,56 @@ func (in *FederatedTypeConfigStatus) DeepCopy() *FederatedTypeConfigStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InterpreterConfig) DeepCopyInto(out *InterpreterConfig) {
+	*out = *in
+	if in.Webhook != nil {
+		in, out := &in.Webhook, &out.Webhook
+		*out = new(InterpreterWebhook)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InterpreterConfig.
+func (in *InterpreterConfig) DeepCopy() *InterpreterConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(InterpreterConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InterpreterWebhook) DeepCopyInto(out *InterpreterWebhook) {
+	*out = *in
+	if in.CABundle != nil {
+		in, out := &in.CABundle, &out.CABundle
+		*out = make([]byte, len(*in))
+		copy(*out, *in)
+	}
+	if in.TimeoutSeconds != nil {
+		in, out := &in.TimeoutSeconds, &out.TimeoutSeconds
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Operations != nil {
+		in, out := &in.Operations, &out.Operations
+		*out = make([]InterpreterOperation, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InterpreterWebhook.
+func (in *InterpreterWebhook) DeepCopy() *InterpreterWebhook {
+	if in == nil {
+		return nil
+	}
+	out := new(InterpreterWebhook)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *KubeFedCluster) DeepCopyInto(out *KubeFedCluster) {
 	*out = *in
@@ -329,6 +455,23 @@ func (in *KubeFedClusterSpec) DeepCopyInto(out *KubeFedClusterSpec) {
 		*out = make([]TLSValidation, len(*in))
 		copy(*out, *in)
 	}
+	if in.Taints != nil {
+		in, out := &in.Taints, &out.Taints
+		*out = make([]corev1.Taint, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.QPS != nil {
+		in, out := &in.QPS, &out.QPS
+		*out = new(int64)
+		**out = **in
+	}
+	if in.Burst != nil {
+		in, out := &in.Burst, &out.Burst
+		*out = new(int64)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubeFedClusterSpec.
@@ -464,6 +607,11 @@ func (in *KubeFedConfigSpec) DeepCopyInto(out *KubeFedConfigSpec) {
 		*out = new(StatusControllerConfig)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.ClusterRateLimit != nil {
+		in, out := &in.ClusterRateLimit, &out.ClusterRateLimit
+		*out = new(ClusterRateLimitConfig)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubeFedConfigSpec.
@@ -526,6 +674,21 @@ func (in *LocalSecretReference) DeepCopy() *LocalSecretReference {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RetainedField) DeepCopyInto(out *RetainedField) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RetainedField.
+func (in *RetainedField) DeepCopy() *RetainedField {
+	if in == nil {
+		return nil
+	}
+	out := new(RetainedField)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *StatusControllerConfig) DeepCopyInto(out *StatusControllerConfig) {
 	*out = *in
@@ -559,6 +722,16 @@ func (in *SyncControllerConfig) DeepCopyInto(out *SyncControllerConfig) {
 		*out = new(ResourceAdoption)
 		**out = **in
 	}
+	if in.OperationTimeout != nil {
+		in, out := &in.OperationTimeout, &out.OperationTimeout
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.MaxInFlightOperations != nil {
+		in, out := &in.MaxInFlightOperations, &out.MaxInFlightOperations
+		*out = new(int64)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SyncControllerConfig.
@@ -0,0 +1,152 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	apiextv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ClusterOverride is a JSON patch operation applied to the managed
+// resource in a cluster.
+type ClusterOverride struct {
+	// Op is the operation to perform. Defaults to replace.
+	// +kubebuilder:validation:Pattern=`^(add|remove|replace|move|copy|test)?$`
+	// +optional
+	Op string `json:"op,omitempty"`
+
+	// Path is the JSON pointer to the field to modify.
+	Path string `json:"path"`
+
+	// From is the JSON pointer to the field to move or copy.
+	// +optional
+	From string `json:"from,omitempty"`
+
+	// Value is the value to add, replace or test.
+	// +optional
+	Value *apiextv1.JSON `json:"value,omitempty"`
+}
+
+// OverrideRule varies the managed resources of selected federated
+// resources in the targeted clusters. Its fields have the same
+// meaning as those of an item of the spec.overrides field of a
+// federated resource.
+type OverrideRule struct {
+	// +optional
+	ClusterName string `json:"clusterName,omitempty"`
+
+	// +optional
+	ClusterSelector *metav1.LabelSelector `json:"clusterSelector,omitempty"`
+
+	// +kubebuilder:validation:Enum=json;merge;strategic;image
+	// +optional
+	PatchType string `json:"patchType,omitempty"`
+
+	// +optional
+	ClusterOverrides []ClusterOverride `json:"clusterOverrides,omitempty"`
+
+	// +kubebuilder:validation:Type=object
+	// +optional
+	Patch *apiextv1.JSON `json:"patch,omitempty"`
+
+	// +optional
+	Image *ImageOverride `json:"image,omitempty"`
+}
+
+// ImageOverride rewrites components of the images of all containers
+// and init containers of a managed resource.
+type ImageOverride struct {
+	// Registry replaces the registry host of an image.
+	// +optional
+	Registry string `json:"registry,omitempty"`
+
+	// Repository replaces the path of an image within its registry.
+	// +optional
+	Repository string `json:"repository,omitempty"`
+
+	// Tag replaces the tag or digest of an image.
+	// +optional
+	Tag string `json:"tag,omitempty"`
+}
+
+// OverridePolicySpec defines the desired state of an OverridePolicy
+// or ClusterOverridePolicy.
+type OverridePolicySpec struct {
+	// ResourceSelectors select the federated resources the policy
+	// applies to. A resource is selected if it matches any selector.
+	ResourceSelectors []ResourceSelector `json:"resourceSelectors"`
+
+	// Priority determines the order in which the policies of the same
+	// scope that select a resource are applied. Policies are applied
+	// in order of increasing priority so that the overrides of the
+	// policy with the highest priority take precedence, and ties are
+	// broken by name.
+	// +optional
+	Priority int32 `json:"priority,omitempty"`
+
+	// Overrides are applied to selected federated resources before
+	// the spec.overrides of the resource.
+	Overrides []OverrideRule `json:"overrides"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:path=overridepolicies,shortName=op
+
+// OverridePolicy provides overrides for federated resources in the
+// same namespace.
+type OverridePolicy struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec OverridePolicySpec `json:"spec"`
+}
+
+// +kubebuilder:object:root=true
+
+// OverridePolicyList contains a list of OverridePolicy
+type OverridePolicyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []OverridePolicy `json:"items"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:path=clusteroverridepolicies,shortName=cop,scope=Cluster
+
+// ClusterOverridePolicy provides overrides for federated resources in
+// any namespace, and for cluster-scoped federated resources. The
+// overrides of an OverridePolicy in the namespace of a resource take
+// precedence over those of a ClusterOverridePolicy.
+type ClusterOverridePolicy struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec OverridePolicySpec `json:"spec"`
+}
+
+// +kubebuilder:object:root=true
+
+// ClusterOverridePolicyList contains a list of ClusterOverridePolicy
+type ClusterOverridePolicyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ClusterOverridePolicy `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&OverridePolicy{}, &OverridePolicyList{}, &ClusterOverridePolicy{}, &ClusterOverridePolicyList{})
+}
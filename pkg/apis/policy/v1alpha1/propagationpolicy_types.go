@@ -0,0 +1,179 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ResourceSelector selects federated resources by kind, namespace and
+// labels.
+type ResourceSelector struct {
+	// Kind of the federated resources to select, e.g. FederatedDeployment.
+	Kind string `json:"kind"`
+
+	// Namespace limits a cluster-scoped policy to the federated
+	// resources in the namespace. Resources in any namespace are
+	// selected if not provided. Ignored by namespaced policies, which
+	// only select resources in their own namespace.
+	// +optional
+	Namespace string `json:"namespace,omitempty"`
+
+	// LabelSelector selects federated resources by their labels. All
+	// resources of the kind are selected if not provided.
+	// +optional
+	LabelSelector *metav1.LabelSelector `json:"labelSelector,omitempty"`
+}
+
+// ClusterReference is a reference to a member cluster by name.
+type ClusterReference struct {
+	Name string `json:"name"`
+}
+
+// SpreadConstraint limits selected clusters to a set that is spread
+// across distinct regions or zones.
+type SpreadConstraint struct {
+	// SpreadBy determines whether clusters are grouped by region or zone.
+	// +kubebuilder:validation:Enum=Region;Zone
+	SpreadBy string `json:"spreadBy"`
+
+	// MaxGroups is the number of distinct regions or zones to select
+	// clusters from. All groups are used if zero.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	MaxGroups int32 `json:"maxGroups,omitempty"`
+
+	// MaxClustersPerGroup is the number of clusters to select from
+	// each region or zone. All clusters in a group are used if zero.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	MaxClustersPerGroup int32 `json:"maxClustersPerGroup,omitempty"`
+}
+
+// Failover configures the temporary replacement of selected clusters
+// that are not ready.
+type Failover struct {
+	// GracePeriodSeconds is how long a selected cluster must not be
+	// ready before a replacement is chosen. Defaults to 5 minutes.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	GracePeriodSeconds *int64 `json:"gracePeriodSeconds,omitempty"`
+
+	// BackupClusters is an ordered list of clusters to choose
+	// replacements from.
+	// +optional
+	BackupClusters []ClusterReference `json:"backupClusters,omitempty"`
+
+	// AnyMatchingCluster indicates that replacements can be chosen
+	// from any other cluster matching the placement once the backup
+	// clusters, if any, have been exhausted.
+	// +optional
+	AnyMatchingCluster bool `json:"anyMatchingCluster,omitempty"`
+}
+
+// Placement determines the member clusters that federated resources
+// are propagated to. Its fields have the same meaning as the
+// spec.placement field of a federated resource.
+type Placement struct {
+	// +optional
+	Clusters []ClusterReference `json:"clusters,omitempty"`
+
+	// +optional
+	ClusterSelector *metav1.LabelSelector `json:"clusterSelector,omitempty"`
+
+	// +optional
+	SpreadConstraint *SpreadConstraint `json:"spreadConstraint,omitempty"`
+
+	// +optional
+	Tolerations []corev1.Toleration `json:"tolerations,omitempty"`
+
+	// +optional
+	Failover *Failover `json:"failover,omitempty"`
+
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	MaxClusters int32 `json:"maxClusters,omitempty"`
+}
+
+// PropagationPolicySpec defines the desired state of a PropagationPolicy
+// or ClusterPropagationPolicy.
+type PropagationPolicySpec struct {
+	// ResourceSelectors select the federated resources the policy
+	// applies to. A resource is selected if it matches any selector.
+	ResourceSelectors []ResourceSelector `json:"resourceSelectors"`
+
+	// Priority determines which policy applies to a resource selected
+	// by more than one policy of the same scope. The policy with the
+	// highest priority applies, and policies with the same priority
+	// are ordered by name.
+	// +optional
+	Priority int32 `json:"priority,omitempty"`
+
+	// Placement is used for selected federated resources that do not
+	// specify either spec.placement.clusters or
+	// spec.placement.clusterSelector.
+	Placement Placement `json:"placement"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:path=propagationpolicies,shortName=pp
+
+// PropagationPolicy provides default placement for federated resources
+// in the same namespace.
+type PropagationPolicy struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec PropagationPolicySpec `json:"spec"`
+}
+
+// +kubebuilder:object:root=true
+
+// PropagationPolicyList contains a list of PropagationPolicy
+type PropagationPolicyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []PropagationPolicy `json:"items"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:path=clusterpropagationpolicies,shortName=cpp,scope=Cluster
+
+// ClusterPropagationPolicy provides default placement for federated
+// resources in any namespace, and for cluster-scoped federated
+// resources. A PropagationPolicy in the namespace of a resource takes
+// precedence over a ClusterPropagationPolicy.
+type ClusterPropagationPolicy struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec PropagationPolicySpec `json:"spec"`
+}
+
+// +kubebuilder:object:root=true
+
+// ClusterPropagationPolicyList contains a list of ClusterPropagationPolicy
+type ClusterPropagationPolicyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ClusterPropagationPolicy `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&PropagationPolicy{}, &PropagationPolicyList{}, &ClusterPropagationPolicy{}, &ClusterPropagationPolicyList{})
+}
@@ -54,6 +54,10 @@ type Controller struct {
 	stopChannels map[string]chan struct{}
 	lock         sync.RWMutex
 
+	// The stores of the federated resources of the running sync
+	// controllers, shared between them.
+	federatedStores *synccontroller.FederatedStores
+
 	// Store for the FederatedTypeConfig objects
 	store cache.Store
 	// Informer for the FederatedTypeConfig objects
@@ -87,6 +91,7 @@ func newController(config *util.ControllerConfig) (*Controller, error) {
 		controllerConfig: config,
 		client:           genericclient,
 		stopChannels:     make(map[string]chan struct{}),
+		federatedStores:  synccontroller.NewFederatedStores(),
 	}
 
 	c.worker = util.NewReconcileWorker("federatedtypeconfig", c.reconcile, util.WorkerOptions{})
@@ -327,7 +332,7 @@ func (c *Controller) startSyncController(tc *corev1b1.FederatedTypeConfig) error
 	}
 
 	stopChan := make(chan struct{})
-	err := synccontroller.StartKubeFedSyncController(c.controllerConfig, stopChan, ftc, fedNamespaceAPIResource)
+	err := synccontroller.StartKubeFedSyncController(c.controllerConfig, stopChan, ftc, fedNamespaceAPIResource, c.federatedStores)
 	if err != nil {
 		close(stopChan)
 		return errors.Wrapf(err, "Error starting sync controller for %q", kind)
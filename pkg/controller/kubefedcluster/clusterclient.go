@@ -69,9 +69,9 @@ type ClusterClient struct {
 // NewClusterClientSet returns a ClusterClient for the given KubeFedCluster.
 // The kubeClient is used to configure the ClusterClient's internal client
 // with information from a kubeconfig stored in a kubernetes secret.
-func NewClusterClientSet(c *fedv1b1.KubeFedCluster, client generic.Client, fedNamespace string, timeout time.Duration) (*ClusterClient, error) {
+func NewClusterClientSet(c *fedv1b1.KubeFedCluster, client generic.Client, fedNamespace string, timeout time.Duration, rateLimit util.ClusterRateLimitConfig) (*ClusterClient, error) {
 	var clusterClientSet = ClusterClient{clusterName: c.Name}
-	clusterConfig, err := util.BuildClusterConfig(c, client, fedNamespace)
+	clusterConfig, err := util.BuildClusterConfig(c, client, fedNamespace, rateLimit)
 	if err != nil {
 		return &clusterClientSet, err
 	}
@@ -78,6 +78,10 @@ type ClusterController struct {
 	// KubeFedCluster resources and their associated secrets.
 	fedNamespace string
 
+	// clusterRateLimit is the default rate limit of the clients of
+	// member clusters.
+	clusterRateLimit util.ClusterRateLimitConfig
+
 	eventRecorder record.EventRecorder
 }
 
@@ -104,6 +108,7 @@ func newClusterController(config *util.ControllerConfig, clusterHealthCheckConfi
 		clusterHealthCheckConfig: clusterHealthCheckConfig,
 		clusterDataMap:           make(map[string]*ClusterData),
 		fedNamespace:             config.KubeFedNamespace,
+		clusterRateLimit:         config.ClusterRateLimit,
 	}
 
 	kubeClient := kubeclient.NewForConfigOrDie(kubeConfig)
@@ -183,7 +188,7 @@ func (cc *ClusterController) addToClusterSet(obj *fedv1b1.KubeFedCluster) {
 	klog.V(1).Infof("ClusterController observed a new cluster: %v", obj.Name)
 
 	// create the restclient of cluster
-	restClient, err := NewClusterClientSet(obj, cc.client, cc.fedNamespace, cc.clusterHealthCheckConfig.Timeout)
+	restClient, err := NewClusterClientSet(obj, cc.client, cc.fedNamespace, cc.clusterHealthCheckConfig.Timeout, cc.clusterRateLimit)
 	if err != nil || restClient.kubeClient == nil {
 		cc.RecordError(obj, "MalformedClusterConfig", errors.Wrap(err, "The configuration for this cluster may be malformed"))
 		klog.Errorf("The configuration for cluster %q may be malformed: %v", obj.Name, err)
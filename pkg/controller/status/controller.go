@@ -40,6 +40,7 @@ import (
 	fedv1b1 "sigs.k8s.io/kubefed/pkg/apis/core/v1beta1"
 	genericclient "sigs.k8s.io/kubefed/pkg/client/generic"
 	"sigs.k8s.io/kubefed/pkg/controller/util"
+	"sigs.k8s.io/kubefed/pkg/interpreter"
 	"sigs.k8s.io/kubefed/pkg/metrics"
 )
 
@@ -75,7 +76,8 @@ type KubeFedStatusController struct {
 
 	cacheSyncTimeout time.Duration
 
-	typeConfig typeconfig.Interface
+	typeConfig  typeconfig.Interface
+	interpreter interpreter.Interpreter
 
 	client       genericclient.Client
 	statusClient util.ResourceClient
@@ -119,12 +121,18 @@ func newKubeFedStatusController(controllerConfig *util.ControllerConfig, typeCon
 		return nil, err
 	}
 
+	interp, err := interpreter.ForTypeConfig(typeConfig)
+	if err != nil {
+		return nil, err
+	}
+
 	s := &KubeFedStatusController{
 		clusterAvailableDelay:   controllerConfig.ClusterAvailableDelay,
 		clusterUnavailableDelay: controllerConfig.ClusterUnavailableDelay,
 		smallDelay:              time.Second * 3,
 		cacheSyncTimeout:        controllerConfig.CacheSyncTimeout,
 		typeConfig:              typeConfig,
+		interpreter:             interp,
 		client:                  client,
 		statusClient:            statusClient,
 		fedNamespace:            controllerConfig.KubeFedNamespace,
@@ -287,6 +295,12 @@ func (s *KubeFedStatusController) reconcile(qualifiedName util.QualifiedName) ut
 		return util.StatusError
 	}
 
+	aggregatedStatus, err := s.interpreter.AggregateStatus(clusterStatus)
+	if err != nil {
+		runtime.HandleError(errors.Wrapf(err, "Failed to aggregate the status of %s %q", federatedKind, key))
+		return util.StatusError
+	}
+
 	existingStatus, err := s.objFromCache(s.statusStore, statusKind, key)
 	if err != nil {
 		return util.StatusError
@@ -311,7 +325,8 @@ func (s *KubeFedStatusController) reconcile(qualifiedName util.QualifiedName) ut
 				UID:        fedObject.GetUID(),
 			}},
 		},
-		ClusterStatus: clusterStatus,
+		ClusterStatus:    clusterStatus,
+		AggregatedStatus: aggregatedStatus,
 	}
 	status, err := util.GetUnstructured(federatedResource)
 	if err != nil {
@@ -325,11 +340,17 @@ func (s *KubeFedStatusController) reconcile(qualifiedName util.QualifiedName) ut
 			runtime.HandleError(errors.Wrapf(err, "Failed to create status object for federated type %s %q", statusKind, key))
 			return util.StatusNeedsRecheck
 		}
-	} else if !reflect.DeepEqual(existingStatus.Object["clusterStatus"], status.Object["clusterStatus"]) {
+	} else if !reflect.DeepEqual(existingStatus.Object["clusterStatus"], status.Object["clusterStatus"]) ||
+		!reflect.DeepEqual(existingStatus.Object["aggregatedStatus"], status.Object["aggregatedStatus"]) {
 		if status.Object["clusterStatus"] == nil {
 			status.Object["clusterStatus"] = make([]util.ResourceClusterStatus, 0)
 		}
 		existingStatus.Object["clusterStatus"] = status.Object["clusterStatus"]
+		if aggregated, ok := status.Object["aggregatedStatus"]; ok {
+			existingStatus.Object["aggregatedStatus"] = aggregated
+		} else {
+			delete(existingStatus.Object, "aggregatedStatus")
+		}
 		_, err = s.statusClient.Resources(qualifiedName.Namespace).Update(context.Background(), existingStatus, metav1.UpdateOptions{})
 		if err != nil {
 			runtime.HandleError(errors.Wrapf(err, "Failed to update status object for federated type %s %q", statusKind, key))
@@ -377,11 +398,11 @@ func (s *KubeFedStatusController) clusterNames() ([]string, error) {
 	return clusterNames, nil
 }
 
-// clusterStatuses returns the resource status in member cluster.
+// clusterStatuses returns the resource status in member cluster. The
+// status of the resources in all member clusters is reflected at once.
 func (s *KubeFedStatusController) clusterStatuses(clusterNames []string, key string) ([]util.ResourceClusterStatus, error) {
-	clusterStatus := []util.ResourceClusterStatus{}
-
 	targetKind := s.typeConfig.GetTargetType().Kind
+	clusterObjs := make(map[string]*unstructured.Unstructured)
 	for _, clusterName := range clusterNames {
 		clusterObj, exist, err := s.informer.GetTargetStore().GetByKey(clusterName, key)
 		if err != nil {
@@ -389,19 +410,21 @@ func (s *KubeFedStatusController) clusterStatuses(clusterNames []string, key str
 			runtime.HandleError(wrappedErr)
 			return nil, wrappedErr
 		}
-
-		var status map[string]interface{}
 		if exist {
-			clusterObj := clusterObj.(*unstructured.Unstructured)
-
-			var found bool
-			status, found, err = unstructured.NestedMap(clusterObj.Object, "status")
-			if err != nil || !found {
-				wrappedErr := errors.Wrapf(err, "Failed to get status of cluster resource object %s %q for cluster %q", targetKind, key, clusterName)
-				runtime.HandleError(wrappedErr)
-			}
+			clusterObjs[clusterName] = clusterObj.(*unstructured.Unstructured)
 		}
-		resourceClusterStatus := util.ResourceClusterStatus{ClusterName: clusterName, Status: status}
+	}
+
+	statuses, err := interpreter.ReflectStatusForClusters(s.interpreter, clusterObjs)
+	if err != nil {
+		wrappedErr := errors.Wrapf(err, "Failed to get status of cluster resource objects %s %q", targetKind, key)
+		runtime.HandleError(wrappedErr)
+		return nil, wrappedErr
+	}
+
+	clusterStatus := []util.ResourceClusterStatus{}
+	for _, clusterName := range clusterNames {
+		resourceClusterStatus := util.ResourceClusterStatus{ClusterName: clusterName, Status: statuses[clusterName]}
 		clusterStatus = append(clusterStatus, resourceClusterStatus)
 	}
 
@@ -17,6 +17,8 @@ limitations under the License.
 package sync
 
 import (
+	"github.com/pkg/errors"
+
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/client-go/tools/cache"
@@ -30,6 +32,7 @@ import (
 	genericclient "sigs.k8s.io/kubefed/pkg/client/generic"
 	"sigs.k8s.io/kubefed/pkg/controller/sync/version"
 	"sigs.k8s.io/kubefed/pkg/controller/util"
+	"sigs.k8s.io/kubefed/pkg/interpreter"
 )
 
 // FederatedResourceAccessor provides a way to retrieve and visit
@@ -39,11 +42,13 @@ type FederatedResourceAccessor interface {
 	HasSynced() bool
 	FederatedResource(qualifiedName util.QualifiedName) (federatedResource FederatedResource, possibleOrphan bool, err error)
 	VisitFederatedResources(visitFunc func(obj interface{}))
+	FederatedStore() cache.Store
 }
 
 type resourceAccessor struct {
 	limitedScope      bool
 	typeConfig        typeconfig.Interface
+	interpreter       interpreter.Interpreter
 	targetIsNamespace bool
 	fedNamespace      string
 
@@ -65,6 +70,11 @@ type resourceAccessor struct {
 	fedNamespaceStore      cache.Store
 	fedNamespaceController cache.Controller
 
+	// The informers used to source the propagation and override
+	// policies, shared with the sync controllers of other types.
+	policyInformers *util.PolicyInformers
+	policyEnqueue   func(runtimeclient.Object)
+
 	// Manages propagated versions
 	versionManager *version.VersionManager
 
@@ -79,9 +89,15 @@ func NewFederatedResourceAccessor(
 	client genericclient.Client,
 	enqueueObj func(runtimeclient.Object),
 	eventRecorder record.EventRecorder) (FederatedResourceAccessor, error) {
+	interp, err := interpreter.ForTypeConfig(typeConfig)
+	if err != nil {
+		return nil, err
+	}
+
 	a := &resourceAccessor{
 		limitedScope:            controllerConfig.LimitedScope(),
 		typeConfig:              typeConfig,
+		interpreter:             interp,
 		targetIsNamespace:       typeConfig.GetTargetType().Kind == util.NamespaceKind,
 		fedNamespace:            controllerConfig.KubeFedNamespace,
 		fedNamespaceAPIResource: fedNamespaceAPIResource,
@@ -136,6 +152,23 @@ func NewFederatedResourceAccessor(
 		a.fedNamespaceStore, a.fedNamespaceController = util.NewResourceInformer(fedNamespaceClient, targetNamespace, fedNamespaceAPIResource, fedNamespaceEnqueue)
 	}
 
+	if controllerConfig.PolicyInformers == nil {
+		return nil, errors.New("The policy informers must be configured")
+	}
+	a.policyInformers = controllerConfig.PolicyInformers
+
+	// When a propagation or override policy changes, every resource
+	// it could apply to needs to be reconciled.
+	a.policyEnqueue = func(policyObj runtimeclient.Object) {
+		namespace := policyObj.GetNamespace()
+		for _, rawObj := range a.federatedStore.List() {
+			obj := rawObj.(runtimeclient.Object)
+			if namespace == "" || obj.GetNamespace() == namespace {
+				enqueueObj(obj)
+			}
+		}
+	}
+
 	a.versionManager = version.NewVersionManager(
 		client,
 		typeConfig.GetFederatedNamespaced(),
@@ -156,6 +189,7 @@ func (a *resourceAccessor) Run(stopChan <-chan struct{}) {
 	if a.fedNamespaceController != nil {
 		go a.fedNamespaceController.Run(stopChan)
 	}
+	a.policyInformers.AddEventHandler(a.policyEnqueue, stopChan)
 }
 
 func (a *resourceAccessor) HasSynced() bool {
@@ -176,6 +210,10 @@ func (a *resourceAccessor) HasSynced() bool {
 		klog.V(2).Infof("FederatedNamespace informer for %s not synced", kind)
 		return false
 	}
+	if !a.policyInformers.HasSynced() {
+		klog.V(2).Infof("Policy informers for %s not synced", kind)
+		return false
+	}
 	return true
 }
 
@@ -265,21 +303,36 @@ func (a *resourceAccessor) FederatedResource(eventSource util.QualifiedName) (Fe
 		// will be removed.
 	}
 
+	policyOverrides, err := util.SelectOverridePolicies(resource, a.policyInformers.OverridePolicies(federatedName.Namespace), a.policyInformers.ClusterOverridePolicies())
+	if err != nil {
+		return nil, false, err
+	}
+
 	return &federatedResource{
-		limitedScope:      a.limitedScope,
-		typeConfig:        a.typeConfig,
-		targetIsNamespace: a.targetIsNamespace,
-		targetName:        targetName,
-		federatedKind:     kind,
-		federatedName:     federatedName,
-		federatedResource: resource,
-		versionManager:    a.versionManager,
-		namespace:         namespace,
-		fedNamespace:      fedNamespace,
-		eventRecorder:     a.eventRecorder,
+		limitedScope:               a.limitedScope,
+		typeConfig:                 a.typeConfig,
+		interpreter:                a.interpreter,
+		targetIsNamespace:          a.targetIsNamespace,
+		targetName:                 targetName,
+		federatedKind:              kind,
+		federatedName:              federatedName,
+		federatedResource:          resource,
+		versionManager:             a.versionManager,
+		namespace:                  namespace,
+		fedNamespace:               fedNamespace,
+		propagationPolicies:        a.policyInformers.PropagationPolicies(federatedName.Namespace),
+		clusterPropagationPolicies: a.policyInformers.ClusterPropagationPolicies(),
+		policyOverrides:            policyOverrides,
+		eventRecorder:              a.eventRecorder,
 	}, false, nil
 }
 
+// FederatedStore returns the informer store of the federated
+// resources.
+func (a *resourceAccessor) FederatedStore() cache.Store {
+	return a.federatedStore
+}
+
 func (a *resourceAccessor) VisitFederatedResources(visitFunc func(obj interface{})) {
 	for _, obj := range a.federatedStore.List() {
 		visitFunc(obj)
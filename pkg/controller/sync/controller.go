@@ -44,6 +44,7 @@ import (
 
 	"sigs.k8s.io/kubefed/pkg/apis/core/typeconfig"
 	fedv1b1 "sigs.k8s.io/kubefed/pkg/apis/core/v1beta1"
+	"sigs.k8s.io/kubefed/pkg/apis/core/v1beta1/defaults"
 	genericclient "sigs.k8s.io/kubefed/pkg/client/generic"
 	"sigs.k8s.io/kubefed/pkg/controller/sync/dispatch"
 	"sigs.k8s.io/kubefed/pkg/controller/sync/status"
@@ -58,6 +59,10 @@ const (
 	// controller will have the opportunity to perform pre-deletion operations
 	// (like deleting managed resources from member clusters).
 	FinalizerSyncController = "kubefed.io/sync-controller"
+
+	// The backoff of member clusters that throttle operations.
+	initialClusterBackoff = time.Second
+	maxClusterBackoff     = 2 * time.Minute
 )
 
 // KubeFedSyncController synchronizes the state of federated resources
@@ -88,16 +93,25 @@ type KubeFedSyncController struct {
 
 	hostClusterClient genericclient.Client
 
+	// The stores of the federated types sync controllers are
+	// running for, used to retrieve dependencies and dependents.
+	federatedStores *FederatedStores
+
 	skipAdoptingResources bool
 
 	limitedScope bool
 
 	rawResourceStatusCollection bool
+
+	operationOptions dispatch.OperationOptions
+
+	// Delays operations on member clusters that throttled operations.
+	clusterBackoff *util.ClusterBackoff
 }
 
 // StartKubeFedSyncController starts a new sync controller for a type config
-func StartKubeFedSyncController(controllerConfig *util.ControllerConfig, stopChan <-chan struct{}, typeConfig typeconfig.Interface, fedNamespaceAPIResource *metav1.APIResource) error {
-	controller, err := newKubeFedSyncController(controllerConfig, typeConfig, fedNamespaceAPIResource)
+func StartKubeFedSyncController(controllerConfig *util.ControllerConfig, stopChan <-chan struct{}, typeConfig typeconfig.Interface, fedNamespaceAPIResource *metav1.APIResource, federatedStores *FederatedStores) error {
+	controller, err := newKubeFedSyncController(controllerConfig, typeConfig, fedNamespaceAPIResource, federatedStores)
 	if err != nil {
 		return err
 	}
@@ -110,7 +124,7 @@ func StartKubeFedSyncController(controllerConfig *util.ControllerConfig, stopCha
 }
 
 // newKubeFedSyncController returns a new sync controller for the configuration
-func newKubeFedSyncController(controllerConfig *util.ControllerConfig, typeConfig typeconfig.Interface, fedNamespaceAPIResource *metav1.APIResource) (*KubeFedSyncController, error) {
+func newKubeFedSyncController(controllerConfig *util.ControllerConfig, typeConfig typeconfig.Interface, fedNamespaceAPIResource *metav1.APIResource, federatedStores *FederatedStores) (*KubeFedSyncController, error) {
 	federatedTypeAPIResource := typeConfig.GetFederatedType()
 	userAgent := fmt.Sprintf("%s-controller", strings.ToLower(federatedTypeAPIResource.Kind))
 
@@ -131,9 +145,12 @@ func newKubeFedSyncController(controllerConfig *util.ControllerConfig, typeConfi
 		eventRecorder:               recorder,
 		typeConfig:                  typeConfig,
 		hostClusterClient:           client,
+		federatedStores:             federatedStores,
 		skipAdoptingResources:       controllerConfig.SkipAdoptingResources,
 		limitedScope:                controllerConfig.LimitedScope(),
 		rawResourceStatusCollection: controllerConfig.RawResourceStatusCollection,
+		operationOptions:            operationOptions(controllerConfig, typeConfig),
+		clusterBackoff:              util.NewClusterBackoff(initialClusterBackoff, maxClusterBackoff),
 	}
 
 	s.worker = util.NewReconcileWorker(strings.ToLower(federatedTypeAPIResource.Kind), s.reconcile, util.WorkerOptions{
@@ -183,6 +200,27 @@ func newKubeFedSyncController(controllerConfig *util.ControllerConfig, typeConfi
 	return s, nil
 }
 
+// operationOptions returns the options for dispatching operations to
+// member clusters for the given type. Settings of the type config take
+// precedence over those of the controller config.
+func operationOptions(controllerConfig *util.ControllerConfig, typeConfig typeconfig.Interface) dispatch.OperationOptions {
+	options := dispatch.OperationOptions{
+		Timeout:         controllerConfig.OperationTimeout,
+		MaxInFlight:     controllerConfig.MaxInFlightOperations,
+		ServerSideApply: typeConfig.GetServerSideApplyEnabled(),
+	}
+	if timeout := typeConfig.GetOperationTimeout(); timeout != nil {
+		options.Timeout = timeout.Duration
+	}
+	if maxInFlight := typeConfig.GetMaxInFlightOperations(); maxInFlight != nil {
+		options.MaxInFlight = *maxInFlight
+	}
+	if options.Timeout <= 0 {
+		options.Timeout = defaults.DefaultSyncControllerOperationTimeout
+	}
+	return options
+}
+
 // minimizeLatency reduces delays and timeouts to make the controller more responsive (useful for testing).
 func (s *KubeFedSyncController) minimizeLatency() {
 	s.clusterAvailableDelay = time.Second
@@ -193,12 +231,14 @@ func (s *KubeFedSyncController) minimizeLatency() {
 
 func (s *KubeFedSyncController) Run(stopChan <-chan struct{}) {
 	s.fedAccessor.Run(stopChan)
+	s.federatedStores.add(s.typeConfig, s.fedAccessor.FederatedStore(), stopChan)
 	s.informer.Start()
 	s.clusterDeliverer.StartWithHandler(func(_ *util.DelayingDelivererItem) {
 		s.reconcileOnClusterChange()
 	})
 
 	s.worker.Run(stopChan)
+	go wait.Until(s.clusterBackoff.GC, time.Minute, stopChan)
 
 	// Ensure all goroutines are cleaned up when the stop channel closes
 	go func() {
@@ -329,7 +369,7 @@ func (s *KubeFedSyncController) syncToClusters(fedResource FederatedResource) ut
 		return s.setFederatedStatus(fedResource, status.ClusterRetrievalFailed, nil, nil, enableRawResourceStatusCollection)
 	}
 
-	selectedClusterNames, err := fedResource.ComputePlacement(clusters)
+	selectedClusterNames, placementDecisions, err := fedResource.ComputePlacementDecisions(clusters)
 	if err != nil {
 		fedResource.RecordError(string(status.ComputePlacementFailed), errors.Wrap(err, "Failed to compute placement"))
 		runtime.HandleError(errors.Wrapf(err, "failed to compute placement"))
@@ -340,7 +380,37 @@ func (s *KubeFedSyncController) syncToClusters(fedResource FederatedResource) ut
 	key := fedResource.TargetName().String()
 	klog.V(4).Infof("Ensuring %s %q in clusters: %s", kind, key, strings.Join(selectedClusterNames.List(), ","))
 
-	dispatcher := dispatch.NewManagedDispatcher(s.informer.GetClientForCluster, fedResource, s.skipAdoptingResources, enableRawResourceStatusCollection)
+	// Changes are not propagated to clusters in later batches of a
+	// staged rollout.
+	pendingClusterNames, rolloutStatus, err := s.computeRollout(fedResource, selectedClusterNames)
+	if err != nil {
+		fedResource.RecordError(string(status.ComputeRolloutFailed), err)
+		runtime.HandleError(errors.Wrapf(err, "failed to compute rollout"))
+		return s.setFederatedStatus(fedResource, status.ComputeRolloutFailed, nil, nil, enableRawResourceStatusCollection)
+	}
+
+	// Ensure placement is recomputed once the failover grace period
+	// has elapsed for a selected cluster that is not ready.
+	if delay, pending, err := util.PendingFailoverDelay(fedResource.Object(), selectedClusterNames, clusters); err != nil {
+		runtime.HandleError(errors.Wrapf(err, "failed to determine pending failover for %s %q", kind, key))
+	} else if pending {
+		klog.V(4).Infof("Failover pending for %s %q in %v", kind, key, delay)
+		s.worker.EnqueueWithDelay(fedResource.FederatedName(), delay)
+	}
+
+	options := s.operationOptions
+	options.ReportOnly = util.IsReportOnly(fedResource.Object(), s.typeConfig.GetReconcileMode())
+	options.ClusterBackoff = s.clusterBackoff
+	dispatcher := dispatch.NewManagedDispatcher(s.informer.GetClientForCluster, fedResource, s.skipAdoptingResources, enableRawResourceStatusCollection, options)
+
+	dependencies := newDependencyChecker(s.federatedStores, fedResource)
+
+	// While propagation is paused, no operations are dispatched but
+	// the status of resources in member clusters is still collected.
+	paused := util.IsPropagationPaused(fedResource.Object())
+	if paused {
+		klog.V(4).Infof("Propagation of %s %q is paused", kind, key)
+	}
 
 	for _, cluster := range clusters {
 		clusterName := cluster.Name
@@ -368,6 +438,17 @@ func (s *KubeFedSyncController) syncToClusters(fedResource FederatedResource) ut
 			clusterObj = rawClusterObj.(*unstructured.Unstructured)
 		}
 
+		// Operations are not dispatched to a cluster that throttled
+		// operations until its backoff has elapsed.
+		if s.clusterBackoff.Remaining(clusterName) > 0 && (selectedCluster || clusterObj != nil) {
+			var remoteStatus interface{}
+			if clusterObj != nil {
+				remoteStatus = clusterObj.Object[util.StatusField]
+			}
+			dispatcher.RecordStatus(clusterName, status.ClusterThrottled, remoteStatus)
+			continue
+		}
+
 		// Resource should not exist in the named cluster
 		if !selectedCluster {
 			if clusterObj == nil {
@@ -379,10 +460,19 @@ func (s *KubeFedSyncController) syncToClusters(fedResource FederatedResource) ut
 				dispatcher.RecordStatus(clusterName, status.WaitingForRemoval, clusterObj.Object[util.StatusField])
 				continue
 			}
+			if paused {
+				dispatcher.RecordStatus(clusterName, status.PropagationPaused, clusterObj.Object[util.StatusField])
+				continue
+			}
 			if fedResource.IsNamespaceInHostCluster(clusterObj) {
 				// Host cluster namespace needs to have the managed
 				// label removed so it won't be cached anymore.
 				dispatcher.RemoveManagedLabel(clusterName, clusterObj)
+				continue
+			}
+			// Resources depending on the resource are removed first.
+			if dependencies.hasDependents(clusterName) {
+				dispatcher.RecordStatus(clusterName, status.WaitingForDependents, clusterObj.Object[util.StatusField])
 			} else {
 				dispatcher.Delete(clusterName)
 			}
@@ -395,9 +485,33 @@ func (s *KubeFedSyncController) syncToClusters(fedResource FederatedResource) ut
 		// creation has reached the target store before attempting
 		// subsequent operations.  Otherwise the object won't be found
 		// but an add operation will fail with AlreadyExists.
-		if clusterObj == nil {
-			dispatcher.Create(clusterName)
-		} else {
+		switch {
+		case paused && clusterObj == nil:
+			dispatcher.RecordStatus(clusterName, status.PropagationPaused, nil)
+		case paused:
+			dispatcher.RecordStatus(clusterName, status.ClusterPropagationOK, clusterObj.Object[util.StatusField])
+		case pendingClusterNames.Has(clusterName) && clusterObj == nil:
+			dispatcher.RecordStatus(clusterName, status.RolloutPending, nil)
+		case pendingClusterNames.Has(clusterName):
+			dispatcher.RecordStatus(clusterName, status.RolloutPending, clusterObj.Object[util.StatusField])
+		case clusterObj == nil:
+			// The resource is only created once the resources it
+			// depends on have been propagated.
+			unready, err := dependencies.unready(clusterName)
+			switch {
+			case err != nil:
+				dispatcher.RecordClusterError(status.DependencyRetrievalFailed, clusterName, err)
+			case len(unready) > 0 && dependencies.cycleError() != nil:
+				// A cycle is not resolved by waiting, so the
+				// resource is not rechecked until it is updated.
+				dispatcher.RecordClusterError(status.DependencyCycle, clusterName, dependencies.cycleError())
+			case len(unready) > 0:
+				klog.V(4).Infof("Waiting for dependencies %v of %s %q to be propagated to cluster %q", unready, kind, key, clusterName)
+				dispatcher.RecordStatus(clusterName, status.WaitingForDependencies, nil)
+			default:
+				dispatcher.Create(clusterName)
+			}
+		default:
 			dispatcher.Update(clusterName, clusterObj)
 		}
 	}
@@ -406,21 +520,64 @@ func (s *KubeFedSyncController) syncToClusters(fedResource FederatedResource) ut
 		fedResource.RecordError("OperationTimeoutError", timeoutErr)
 		runtime.HandleError(errors.Wrapf(timeoutErr, "operation timeout"))
 	}
-	// Write updated versions to the API.
-	updatedVersionMap := dispatcher.VersionMap()
-	err = fedResource.UpdateVersions(selectedClusterNames.List(), updatedVersionMap)
+	// Write updated versions to the API. Versions are not written
+	// while propagation is paused, since recording the current
+	// template version would prevent changes made in the meantime
+	// from being propagated once propagation resumes.
+	if !paused {
+		updatedVersionMap := dispatcher.VersionMap()
+		err = fedResource.UpdateVersions(selectedClusterNames.List(), updatedVersionMap)
+		if err != nil {
+			// Versioning of federated resources is an optimization to
+			// avoid unnecessary updates, and failure to record version
+			// information does not indicate a failure of propagation.
+			runtime.HandleError(err)
+		}
+	}
+
+	collectedStatus, collectedResourceStatus := dispatcher.CollectedStatus()
+	collectedStatus.PlacementDecisions = placementDecisions
+	collectedStatus.PropagationPolicy = fedResource.PropagationPolicy()
+	collectedStatus.Rollout = rolloutStatus
+	collectedStatus.Health, err = s.computeHealth(fedResource, clusters, selectedClusterNames)
 	if err != nil {
-		// Versioning of federated resources is an optimization to
-		// avoid unnecessary updates, and failure to record version
-		// information does not indicate a failure of propagation.
+		fedResource.RecordError(string(status.HealthCheckFailed), err)
 		runtime.HandleError(err)
+		collectedStatus.HealthError = err.Error()
+	}
+
+	// Reconcile again once the clusters that throttled operations are
+	// no longer backed off.
+	if delay := s.throttledDelay(collectedStatus.StatusMap); delay > 0 {
+		klog.V(4).Infof("Operations on %s %q are throttled, reconciling again in %v", kind, key, delay)
+		s.worker.EnqueueWithDelay(fedResource.FederatedName(), delay)
 	}
 
-	collectedStatus, collectedResourceStatus := dispatcher.CollectedStatus()
 	klog.V(4).Infof("Setting the federated status '%v' for %s %q", collectedResourceStatus, kind, key)
 	return s.setFederatedStatus(fedResource, status.AggregateSuccess, &collectedStatus, &collectedResourceStatus, enableRawResourceStatusCollection)
 }
 
+// throttledDelay returns the longest remaining backoff of the clusters
+// reported as throttled in the given status map, or zero if no cluster
+// is reported as throttled.
+func (s *KubeFedSyncController) throttledDelay(statusMap status.PropagationStatusMap) time.Duration {
+	var delay time.Duration
+	for clusterName, value := range statusMap {
+		if value != status.ClusterThrottled {
+			continue
+		}
+		// A backoff may have elapsed while waiting for operations.
+		remaining := s.clusterBackoff.Remaining(clusterName)
+		if remaining < s.smallDelay {
+			remaining = s.smallDelay
+		}
+		if remaining > delay {
+			delay = remaining
+		}
+	}
+	return delay
+}
+
 func (s *KubeFedSyncController) setFederatedStatus(fedResource FederatedResource,
 	reason status.AggregateReason, collectedStatus *status.CollectedPropagationStatus, collectedResourceStatus *status.CollectedResourceStatus, resourceStatusCollection bool) util.ReconciliationStatus {
 	if collectedStatus == nil {
@@ -429,6 +586,7 @@ func (s *KubeFedSyncController) setFederatedStatus(fedResource FederatedResource
 	if collectedResourceStatus == nil {
 		collectedResourceStatus = &status.CollectedResourceStatus{}
 	}
+	collectedStatus.Paused = util.IsPropagationPaused(fedResource.Object())
 
 	kind := fedResource.FederatedKind()
 	name := fedResource.FederatedName()
@@ -477,10 +635,17 @@ func (s *KubeFedSyncController) setFederatedStatus(fedResource FederatedResource
 
 	// return Error to trigger a retry with back off on recoverable propagation failure
 	if reason == status.AggregateSuccess {
+		needsRecheck := false
 		for _, value := range collectedStatus.StatusMap {
 			if status.IsRecoverableError(value) {
 				return util.StatusError
 			}
+			needsRecheck = needsRecheck || status.IsWaitingForDependencies(value)
+		}
+		// Dependencies and dependents are not watched, so their
+		// progress needs to be rechecked.
+		if needsRecheck {
+			return util.StatusNeedsRecheck
 		}
 	}
 
@@ -587,6 +752,8 @@ func (s *KubeFedSyncController) deleteFromClusters(fedResource FederatedResource
 		return false, err
 	}
 
+	dependencies := newDependencyChecker(s.federatedStores, fedResource)
+
 	remainingClusters := []string{}
 	ok, err := s.handleDeletionInClusters(gvk, qualifiedName, targetClusters, func(dispatcher dispatch.UnmanagedDispatcher, clusterName string, clusterObj *unstructured.Unstructured) {
 		// If the containing namespace of a FederatedNamespace is
@@ -611,7 +778,11 @@ func (s *KubeFedSyncController) deleteFromClusters(fedResource FederatedResource
 			// Removing the managed label will ensure a host cluster
 			// namespace is no longer cached.
 			dispatcher.RemoveManagedLabel(clusterName, clusterObj)
-		} else {
+			return
+		}
+
+		// Resources depending on the resource are removed first.
+		if !dependencies.hasDependents(clusterName) {
 			dispatcher.Delete(clusterName, opts...)
 		}
 	})
@@ -653,7 +824,7 @@ func (s *KubeFedSyncController) ensureRemovedOrUnmanaged(fedResource FederatedRe
 		return errors.Wrapf(err, "failed to compute placement for %s %q", fedResource.FederatedKind(), fedResource.FederatedName().Name)
 	}
 
-	dispatcher := dispatch.NewCheckUnmanagedDispatcher(s.informer.GetClientForCluster, fedResource.TargetGVK(), fedResource.TargetName())
+	dispatcher := dispatch.NewCheckUnmanagedDispatcher(s.informer.GetClientForCluster, fedResource.TargetGVK(), fedResource.TargetName(), s.operationOptions)
 	unreadyClusters := []string{}
 	for _, cluster := range clusters {
 		if !targetClusters.Has(cluster.Name) {
@@ -687,7 +858,7 @@ func (s *KubeFedSyncController) handleDeletionInClusters(gvk schema.GroupVersion
 		return false, errors.Wrap(err, "failed to get a list of clusters")
 	}
 
-	dispatcher := dispatch.NewUnmanagedDispatcher(s.informer.GetClientForCluster, gvk, qualifiedName)
+	dispatcher := dispatch.NewUnmanagedDispatcher(s.informer.GetClientForCluster, gvk, qualifiedName, s.operationOptions)
 	retrievalFailureClusters := []string{}
 	unreadyClusters := []string{}
 	for _, cluster := range memberClusters {
@@ -0,0 +1,198 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sync
+
+import (
+	"github.com/pkg/errors"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/klog/v2"
+
+	"sigs.k8s.io/kubefed/pkg/controller/sync/status"
+	"sigs.k8s.io/kubefed/pkg/controller/util"
+)
+
+// dependencyChecker determines whether the dependencies of a
+// federated resource have been propagated to member clusters, and
+// whether resources of its dependents remain in member clusters.
+// Dependencies and dependents are retrieved from the stores of the
+// federated types sync controllers are running for, and only once
+// they are first checked.
+type dependencyChecker struct {
+	stores      *FederatedStores
+	fedResource FederatedResource
+
+	// The clusters that resources of dependents may exist in, nil
+	// until first checked.
+	dependentClusters sets.String
+
+	loaded       bool
+	dependencies []util.GenericDependency
+	// The retrieved dependencies, nil for a dependency that does not
+	// exist.
+	dependencyObjs []*unstructured.Unstructured
+	// The chain of dependencies through which the federated resource
+	// depends on itself, nil if there is none.
+	cycle []util.GenericDependency
+}
+
+func newDependencyChecker(stores *FederatedStores, fedResource FederatedResource) *dependencyChecker {
+	return &dependencyChecker{
+		stores:      stores,
+		fedResource: fedResource,
+	}
+}
+
+// unready returns the dependencies that have not been propagated to
+// the named cluster.
+func (c *dependencyChecker) unready(clusterName string) ([]util.GenericDependency, error) {
+	if !c.loaded {
+		err := c.load()
+		if err != nil {
+			return nil, err
+		}
+	}
+	var unready []util.GenericDependency
+	for i, dependency := range c.dependencies {
+		obj := c.dependencyObjs[i]
+		if obj == nil || !propagatedToCluster(obj, clusterName) {
+			unready = append(unready, dependency)
+		}
+	}
+	return unready, nil
+}
+
+// load retrieves the dependencies of the federated resource. A
+// dependency of a type no sync controller is running for is treated
+// as not existing since it cannot be propagated.
+func (c *dependencyChecker) load() error {
+	fedObj := c.fedResource.Object()
+	dependencies, err := util.GetDependencies(fedObj)
+	if err != nil {
+		return errors.Wrap(err, "Failed to determine dependencies")
+	}
+	dependencyObjs := make([]*unstructured.Unstructured, len(dependencies))
+	for i, dependency := range dependencies {
+		obj, err := c.getDependency(fedObj, dependency)
+		if err != nil {
+			return errors.Wrapf(err, "Failed to retrieve dependency %s", dependency)
+		}
+		dependencyObjs[i] = obj
+	}
+	cycle, err := util.FindDependencyCycle(fedObj, c.getDependency)
+	if err != nil {
+		return err
+	}
+	c.dependencies = dependencies
+	c.dependencyObjs = dependencyObjs
+	c.cycle = cycle
+	c.loaded = true
+	return nil
+}
+
+// getDependency retrieves the given dependency of the given dependent
+// from the stores.
+func (c *dependencyChecker) getDependency(dependent *unstructured.Unstructured, dependency util.GenericDependency) (*unstructured.Unstructured, error) {
+	groupKind := dependency.GroupVersionKind(dependent).GroupKind()
+	qualifiedName := util.QualifiedName{Namespace: dependent.GetNamespace(), Name: dependency.Name}
+	return c.stores.get(groupKind, qualifiedName)
+}
+
+// cycleError describes the cycle of dependencies of the federated
+// resource, or returns nil if there is none. Only valid once the
+// dependencies have been loaded.
+func (c *dependencyChecker) cycleError() error {
+	if c.cycle == nil {
+		return nil
+	}
+	return errors.Errorf("Dependencies form a cycle: %s", util.DependencyChainString(c.fedResource.Object(), c.cycle))
+}
+
+// hasDependents checks whether resources of federated resources
+// depending on the federated resource may still exist in the named
+// cluster. The resource of the federated resource should not be
+// removed from the cluster until they have been removed.
+func (c *dependencyChecker) hasDependents(clusterName string) bool {
+	if c.dependentClusters == nil {
+		c.dependentClusters = c.clustersWithDependents()
+	}
+	return c.dependentClusters.Has(clusterName)
+}
+
+// clustersWithDependents returns the names of the clusters reported
+// in the status of federated resources depending on the federated
+// resource.
+func (c *dependencyChecker) clustersWithDependents() sets.String {
+	clusterNames := sets.NewString()
+	obj := c.fedResource.Object()
+	// Only resources in the same namespace may depend on a
+	// namespaced resource, and dependents of a cluster-scoped
+	// resource may be in any namespace.
+	c.stores.visit(obj.GetNamespace(), func(dependent *unstructured.Unstructured) {
+		dependsOn, err := util.DependsOn(dependent, obj)
+		if err != nil {
+			klog.V(4).Infof("Ignoring dependencies of %s %q: %v", dependent.GetKind(), util.NewQualifiedName(dependent), err)
+			return
+		}
+		if dependsOn {
+			clusterNames.Insert(reportedClusterNames(dependent)...)
+		}
+	})
+	return clusterNames
+}
+
+// propagatedToCluster checks whether the status of the given
+// federated resource reports that it was successfully propagated to
+// the named cluster.
+func propagatedToCluster(obj *unstructured.Unstructured, clusterName string) bool {
+	clusters, _, _ := unstructured.NestedSlice(obj.Object, util.StatusField, util.ClustersField)
+	for _, rawCluster := range clusters {
+		cluster, ok := rawCluster.(map[string]interface{})
+		if !ok || cluster[util.NameField] != clusterName {
+			continue
+		}
+		propStatus, _ := cluster[util.StatusField].(string)
+		return status.PropagationStatus(propStatus) == status.ClusterPropagationOK
+	}
+	return false
+}
+
+// reportedClusterNames returns the names of the clusters reported in
+// the status of the given federated resource, excluding those where
+// creation waits for its dependencies or is prevented by a cycle of
+// dependencies. Resources may exist in these clusters on behalf of
+// the federated resource.
+func reportedClusterNames(obj *unstructured.Unstructured) []string {
+	var clusterNames []string
+	clusters, _, _ := unstructured.NestedSlice(obj.Object, util.StatusField, util.ClustersField)
+	for _, rawCluster := range clusters {
+		cluster, ok := rawCluster.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		propStatus, _ := cluster[util.StatusField].(string)
+		switch status.PropagationStatus(propStatus) {
+		case status.WaitingForDependencies, status.DependencyCycle:
+			continue
+		}
+		if clusterName, ok := cluster[util.NameField].(string); ok {
+			clusterNames = append(clusterNames, clusterName)
+		}
+	}
+	return clusterNames
+}
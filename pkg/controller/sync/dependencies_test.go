@@ -0,0 +1,230 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sync
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	apiextv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/client-go/tools/cache"
+
+	fedv1b1 "sigs.k8s.io/kubefed/pkg/apis/core/v1beta1"
+)
+
+func TestDependencyChecker(t *testing.T) {
+	configMap := decodeObject(t, `
+apiVersion: types.kubefed.io/v1beta1
+kind: FederatedConfigMap
+metadata:
+  name: config
+  namespace: ns
+status:
+  clusters:
+  - name: cluster1
+  - name: cluster2
+    status: CreationFailed
+`)
+	deployment := decodeObject(t, `
+apiVersion: types.kubefed.io/v1beta1
+kind: FederatedDeployment
+metadata:
+  name: app
+  namespace: ns
+spec:
+  dependsOn:
+  - kind: FederatedConfigMap
+    name: config
+  - kind: FederatedSecret
+    name: secret
+status:
+  clusters:
+  - name: cluster1
+  - name: cluster3
+    status: WaitingForDependencies
+`)
+	otherNamespaceDeployment := decodeObject(t, `
+apiVersion: types.kubefed.io/v1beta1
+kind: FederatedDeployment
+metadata:
+  name: app
+  namespace: other
+spec:
+  dependsOn:
+  - kind: FederatedConfigMap
+    name: config
+status:
+  clusters:
+  - name: cluster4
+`)
+
+	stores := NewFederatedStores()
+	stopChan := make(chan struct{})
+	defer close(stopChan)
+	for kind, objs := range map[string][]*unstructured.Unstructured{
+		"FederatedConfigMap":  {configMap},
+		"FederatedDeployment": {deployment, otherNamespaceDeployment},
+	} {
+		store := cache.NewStore(cache.MetaNamespaceKeyFunc)
+		for _, obj := range objs {
+			if err := store.Add(obj); err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+		}
+		stores.add(newNamespacedTypeConfig(kind), store, stopChan)
+	}
+
+	checker := newDependencyChecker(stores, &fakeDependencyResource{obj: deployment})
+	for clusterName, expected := range map[string][]string{
+		"cluster1": {"FederatedSecret secret"},
+		"cluster2": {"FederatedConfigMap config", "FederatedSecret secret"},
+	} {
+		unready, err := checker.unready(clusterName)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		var unreadyNames []string
+		for _, dependency := range unready {
+			unreadyNames = append(unreadyNames, dependency.Kind+" "+dependency.Name)
+		}
+		if !reflect.DeepEqual(unreadyNames, expected) {
+			t.Fatalf("Expected unready dependencies %v for cluster %q, got %v", expected, clusterName, unreadyNames)
+		}
+	}
+
+	checker = newDependencyChecker(stores, &fakeDependencyResource{obj: configMap})
+	for clusterName, expected := range map[string]bool{
+		"cluster1": true,
+		"cluster2": false,
+		"cluster3": false,
+		"cluster4": false,
+	} {
+		if hasDependents := checker.hasDependents(clusterName); hasDependents != expected {
+			t.Fatalf("Expected dependents in cluster %q to be %v, got %v", clusterName, expected, hasDependents)
+		}
+	}
+}
+
+func TestDependencyCheckerCycle(t *testing.T) {
+	configMap := decodeObject(t, `
+apiVersion: types.kubefed.io/v1beta1
+kind: FederatedConfigMap
+metadata:
+  name: config
+  namespace: ns
+spec:
+  dependsOn:
+  - kind: FederatedDeployment
+    name: app
+`)
+	deployment := decodeObject(t, `
+apiVersion: types.kubefed.io/v1beta1
+kind: FederatedDeployment
+metadata:
+  name: app
+  namespace: ns
+spec:
+  dependsOn:
+  - kind: FederatedConfigMap
+    name: config
+`)
+
+	stores := NewFederatedStores()
+	stopChan := make(chan struct{})
+	defer close(stopChan)
+	for _, obj := range []*unstructured.Unstructured{configMap, deployment} {
+		store := cache.NewStore(cache.MetaNamespaceKeyFunc)
+		if err := store.Add(obj); err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		stores.add(newNamespacedTypeConfig(obj.GetKind()), store, stopChan)
+	}
+
+	checker := newDependencyChecker(stores, &fakeDependencyResource{obj: deployment})
+	unready, err := checker.unready("cluster1")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(unready) != 1 {
+		t.Fatalf("Expected 1 unready dependency, got %v", unready)
+	}
+	expectedErr := `Dependencies form a cycle: FederatedDeployment "app" -> FederatedConfigMap "config" -> FederatedDeployment "app"`
+	if err := checker.cycleError(); err == nil || err.Error() != expectedErr {
+		t.Fatalf("Expected error %q, got %v", expectedErr, err)
+	}
+}
+
+func TestReportedClusters(t *testing.T) {
+	obj := decodeObject(t, `
+apiVersion: types.kubefed.io/v1beta1
+kind: FederatedDeployment
+metadata:
+  name: app
+  namespace: ns
+status:
+  clusters:
+  - name: ok
+  - name: failed
+    status: CreationFailed
+  - name: waiting
+    status: WaitingForDependencies
+  - name: cycle
+    status: DependencyCycle
+`)
+
+	for clusterName, expected := range map[string]bool{"ok": true, "failed": false, "waiting": false, "cycle": false, "missing": false} {
+		if propagated := propagatedToCluster(obj, clusterName); propagated != expected {
+			t.Errorf("Expected propagation to cluster %q to be %v, got %v", clusterName, expected, propagated)
+		}
+	}
+
+	expectedNames := []string{"ok", "failed"}
+	if names := reportedClusterNames(obj); !reflect.DeepEqual(names, expectedNames) {
+		t.Fatalf("Expected reported clusters %v, got %v", expectedNames, names)
+	}
+}
+
+func newNamespacedTypeConfig(federatedKind string) *fedv1b1.FederatedTypeConfig {
+	return &fedv1b1.FederatedTypeConfig{
+		Spec: fedv1b1.FederatedTypeConfigSpec{
+			TargetType: fedv1b1.APIResource{
+				Kind:  strings.TrimPrefix(federatedKind, "Federated"),
+				Scope: apiextv1.NamespaceScoped,
+			},
+			FederatedType: fedv1b1.APIResource{
+				Group:   "types.kubefed.io",
+				Version: "v1beta1",
+				Kind:    federatedKind,
+				Scope:   apiextv1.NamespaceScoped,
+			},
+		},
+	}
+}
+
+// fakeDependencyResource provides the object of a federated resource
+// to a dependency checker.
+type fakeDependencyResource struct {
+	FederatedResource
+
+	obj *unstructured.Unstructured
+}
+
+func (r *fakeDependencyResource) Object() *unstructured.Unstructured {
+	return r.obj
+}
@@ -47,8 +47,8 @@ type checkUnmanagedDispatcherImpl struct {
 	targetName util.QualifiedName
 }
 
-func NewCheckUnmanagedDispatcher(clientAccessor clientAccessorFunc, targetGVK schema.GroupVersionKind, targetName util.QualifiedName) CheckUnmanagedDispatcher {
-	dispatcher := newOperationDispatcher(clientAccessor, nil)
+func NewCheckUnmanagedDispatcher(clientAccessor clientAccessorFunc, targetGVK schema.GroupVersionKind, targetName util.QualifiedName, options OperationOptions) CheckUnmanagedDispatcher {
+	dispatcher := newOperationDispatcher(clientAccessor, nil, options)
 	return &checkUnmanagedDispatcherImpl{
 		dispatcher: dispatcher,
 		targetGVK:  targetGVK,
@@ -67,7 +67,7 @@ func (d *checkUnmanagedDispatcherImpl) CheckRemovedOrUnlabeled(clusterName strin
 	d.dispatcher.incrementOperationsInitiated()
 	const op = "check for deletion of resource or removal of managed label from"
 	const opContinuous = "Checking for deletion of resource or removal of managed label from"
-	go d.dispatcher.clusterOperation(clusterName, op, func(client generic.Client) util.ReconciliationStatus {
+	d.dispatcher.clusterOperation(clusterName, op, func(client generic.Client) util.ReconciliationStatus {
 		targetName := d.targetNameForCluster(clusterName)
 
 		klog.V(2).Infof(eventTemplate, opContinuous, d.targetGVK.Kind, targetName, clusterName)
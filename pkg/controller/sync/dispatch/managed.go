@@ -26,24 +26,33 @@ import (
 	"github.com/pkg/errors"
 
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/klog/v2"
 	runtimeclient "sigs.k8s.io/controller-runtime/pkg/client"
 
+	fedv1b1 "sigs.k8s.io/kubefed/pkg/apis/core/v1beta1"
 	"sigs.k8s.io/kubefed/pkg/client/generic"
 	"sigs.k8s.io/kubefed/pkg/controller/sync/status"
 	"sigs.k8s.io/kubefed/pkg/controller/util"
+	"sigs.k8s.io/kubefed/pkg/interpreter"
 	"sigs.k8s.io/kubefed/pkg/metrics"
 )
 
+// maxDriftedPaths is the maximum number of drifted paths recorded
+// in the status of a federated resource for a cluster.
+const maxDriftedPaths = 10
+
 // FederatedResourceForDispatch is the subset of the FederatedResource
 // interface required for dispatching operations to managed resources.
 type FederatedResourceForDispatch interface {
 	TargetName() util.QualifiedName
 	TargetKind() string
 	TargetGVK() schema.GroupVersionKind
+	RetainedFields() []fedv1b1.RetainedField
+	Interpreter() interpreter.Interpreter
 	Object() *unstructured.Unstructured
 	VersionForCluster(clusterName string) (string, error)
 	ObjectForCluster(clusterName string) (*unstructured.Unstructured, error)
@@ -77,6 +86,13 @@ type managedDispatcherImpl struct {
 	statusMap             status.PropagationStatusMap
 	resourceStatusMap     map[string]interface{}
 	skipAdoptingResources bool
+	serverSideApply       bool
+	reportOnly            bool
+	clusterBackoff        *util.ClusterBackoff
+
+	// The paths of the fields that differ from the desired state,
+	// keyed by the name of a cluster whose resource is drifted.
+	driftMap map[string][]string
 
 	// Track when resource updates are performed to allow indicating
 	// when a change was last propagated to member clusters.
@@ -85,16 +101,20 @@ type managedDispatcherImpl struct {
 	rawResourceStatusCollection bool
 }
 
-func NewManagedDispatcher(clientAccessor clientAccessorFunc, fedResource FederatedResourceForDispatch, skipAdoptingResources, rawResourceStatusCollection bool) ManagedDispatcher {
+func NewManagedDispatcher(clientAccessor clientAccessorFunc, fedResource FederatedResourceForDispatch, skipAdoptingResources, rawResourceStatusCollection bool, options OperationOptions) ManagedDispatcher {
 	d := &managedDispatcherImpl{
 		fedResource:                 fedResource,
 		versionMap:                  make(map[string]string),
 		statusMap:                   make(status.PropagationStatusMap),
 		resourceStatusMap:           make(map[string]interface{}),
 		skipAdoptingResources:       skipAdoptingResources,
+		serverSideApply:             options.ServerSideApply,
+		reportOnly:                  options.ReportOnly,
+		clusterBackoff:              options.ClusterBackoff,
+		driftMap:                    make(map[string][]string),
 		rawResourceStatusCollection: rawResourceStatusCollection,
 	}
-	d.dispatcher = newOperationDispatcher(clientAccessor, d)
+	d.dispatcher = newOperationDispatcher(clientAccessor, d, options)
 	d.unmanagedDispatcher = newUnmanagedDispatcher(d.dispatcher, d, fedResource.TargetGVK(), fedResource.TargetName())
 	return d
 }
@@ -142,7 +162,7 @@ func (d *managedDispatcherImpl) Create(clusterName string) {
 	start := time.Now()
 	d.dispatcher.incrementOperationsInitiated()
 	const op = "create"
-	go d.dispatcher.clusterOperation(clusterName, op, func(client generic.Client) util.ReconciliationStatus {
+	d.dispatcher.clusterOperation(clusterName, op, func(client generic.Client) util.ReconciliationStatus {
 		d.recordEvent(clusterName, op, "Creating")
 
 		obj, err := d.fedResource.ObjectForCluster(clusterName)
@@ -155,6 +175,10 @@ func (d *managedDispatcherImpl) Create(clusterName string) {
 			return d.recordOperationError(status.ApplyOverridesFailed, clusterName, op, err)
 		}
 
+		if d.serverSideApply {
+			return d.createWithApply(client, clusterName, op, obj, start)
+		}
+
 		err = client.Create(context.Background(), obj)
 		if err == nil {
 			version := util.ObjectVersion(obj)
@@ -198,7 +222,7 @@ func (d *managedDispatcherImpl) Update(clusterName string, clusterObj *unstructu
 
 	d.dispatcher.incrementOperationsInitiated()
 	const op = "update"
-	go d.dispatcher.clusterOperation(clusterName, op, func(client generic.Client) util.ReconciliationStatus {
+	d.dispatcher.clusterOperation(clusterName, op, func(client generic.Client) util.ReconciliationStatus {
 		if util.IsExplicitlyUnmanaged(clusterObj) {
 			err := errors.Errorf("Unable to manage the object which has label %s: %s", util.ManagedByKubeFedLabelKey, util.UnmanagedByKubeFedLabelValue)
 			return d.recordOperationError(status.ManagedLabelFalse, clusterName, op, err)
@@ -209,7 +233,7 @@ func (d *managedDispatcherImpl) Update(clusterName string, clusterObj *unstructu
 			return d.recordOperationError(status.ComputeResourceFailed, clusterName, op, err)
 		}
 
-		err = RetainClusterFields(d.fedResource.TargetKind(), obj, clusterObj, d.fedResource.Object())
+		err = RetainFieldsForUpdate(d.fedResource, obj, clusterObj, d.serverSideApply)
 		if err != nil {
 			wrappedErr := errors.Wrapf(err, "failed to retain fields")
 			return d.recordOperationError(status.FieldRetentionFailed, clusterName, op, wrappedErr)
@@ -224,16 +248,27 @@ func (d *managedDispatcherImpl) Update(clusterName string, clusterObj *unstructu
 		if err != nil {
 			return d.recordOperationError(status.VersionRetrievalFailed, clusterName, op, err)
 		}
-		if !util.ObjectNeedsUpdate(obj, clusterObj, version) {
+		if !util.ObjectNeedsUpdate(obj, clusterObj, version, d.serverSideApply) {
 			// Resource is current
 			d.RecordStatus(clusterName, status.UpdateTimedOut, clusterObj.Object[util.StatusField])
 			return util.StatusAllOK
 		}
 
+		if d.reportOnly {
+			return d.reportDrift(clusterName, obj, clusterObj)
+		}
+
 		// Only record an event if the resource is not current
 		d.recordEvent(clusterName, op, "Updating")
 
-		err = client.Update(context.Background(), obj)
+		if d.serverSideApply {
+			err = applyObject(client, obj, clusterObj)
+		} else {
+			err = client.Update(context.Background(), obj)
+		}
+		if apierrors.IsConflict(err) && d.serverSideApply {
+			return d.recordOperationError(status.ApplyConflict, clusterName, op, err)
+		}
 		if err != nil {
 			return d.recordOperationError(status.UpdateFailed, clusterName, op, err)
 		}
@@ -245,6 +280,95 @@ func (d *managedDispatcherImpl) Update(clusterName string, clusterObj *unstructu
 	})
 }
 
+// reportDrift records the fields of the cluster object that differ
+// from the desired object instead of updating the cluster object.
+func (d *managedDispatcherImpl) reportDrift(clusterName string, obj, clusterObj *unstructured.Unstructured) util.ReconciliationStatus {
+	paths := util.DriftedPaths(obj, clusterObj)
+	if len(paths) == 0 {
+		// The cluster object differs from the recorded version but
+		// matches the desired state. Recording its version avoids
+		// comparing it again until either changes.
+		d.recordVersion(clusterName, util.ObjectVersion(clusterObj))
+		d.RecordStatus(clusterName, status.UpdateTimedOut, clusterObj.Object[util.StatusField])
+		return util.StatusAllOK
+	}
+	if len(paths) > maxDriftedPaths {
+		paths = paths[:maxDriftedPaths]
+	}
+
+	klog.V(4).Infof("%s %q in cluster %q differs from the desired state at %v", d.fedResource.TargetKind(), d.unmanagedDispatcher.targetNameForCluster(clusterName), clusterName, paths)
+	metrics.DriftDetectedInc(d.fedResource.TargetKind(), clusterName)
+
+	d.Lock()
+	d.driftMap[clusterName] = paths
+	d.Unlock()
+	d.RecordStatus(clusterName, status.Drifted, clusterObj.Object[util.StatusField])
+	return util.StatusAllOK
+}
+
+// createWithApply creates the resource in the named cluster with
+// server-side apply. Since applying would also adopt a pre-existing
+// resource, the resource is first retrieved if adoption is disabled.
+func (d *managedDispatcherImpl) createWithApply(client generic.Client, clusterName, op string, obj *unstructured.Unstructured, start time.Time) util.ReconciliationStatus {
+	if d.skipAdoptingResources {
+		clusterObj := &unstructured.Unstructured{}
+		clusterObj.SetGroupVersionKind(obj.GroupVersionKind())
+		err := client.Get(context.Background(), clusterObj, obj.GetNamespace(), obj.GetName())
+		switch {
+		case err == nil:
+			if !d.fedResource.IsNamespaceInHostCluster(clusterObj) {
+				_ = d.recordOperationError(status.AlreadyExists, clusterName, op, errors.Errorf("Resource pre-exist in cluster"))
+				return util.StatusAllOK
+			}
+		case !apierrors.IsNotFound(err):
+			wrappedErr := errors.Wrapf(err, "failed to retrieve object potentially requiring adoption")
+			return d.recordOperationError(status.RetrievalFailed, clusterName, op, wrappedErr)
+		}
+	}
+
+	err := applyObject(client, obj, nil)
+	if apierrors.IsConflict(err) {
+		return d.recordOperationError(status.ApplyConflict, clusterName, op, err)
+	}
+	if err != nil {
+		return d.recordOperationError(status.CreationFailed, clusterName, op, err)
+	}
+	version := util.ObjectVersion(obj)
+	d.recordVersion(clusterName, version)
+	d.RecordStatus(clusterName, status.CreationTimedOut, obj.Object[util.StatusField])
+	metrics.DispatchOperationDurationFromStart("create", start)
+	return util.StatusAllOK
+}
+
+// applyObject applies the given object with server-side apply. Fields
+// owned by other field managers are not forced, so setting one of
+// them to a different value results in a conflict.
+//
+// The exception is a cluster object that KubeFed has not applied
+// before, e.g. one last updated by KubeFed before server-side apply
+// was enabled for its type. The fields of such an object were set by
+// KubeFed with a field manager other than the one used for applying,
+// so ownership of the applied fields is forced to migrate them rather
+// than reporting a conflict for every field KubeFed changes.
+func applyObject(client generic.Client, obj, clusterObj *unstructured.Unstructured) error {
+	opts := []runtimeclient.PatchOption{runtimeclient.FieldOwner(util.FieldManager)}
+	if clusterObj != nil && !appliedByKubeFed(clusterObj) {
+		opts = append(opts, runtimeclient.ForceOwnership)
+	}
+	return client.Patch(context.Background(), obj, runtimeclient.Apply, opts...)
+}
+
+// appliedByKubeFed indicates whether the given cluster object has
+// fields owned by the KubeFed field manager through server-side apply.
+func appliedByKubeFed(clusterObj *unstructured.Unstructured) bool {
+	for _, entry := range clusterObj.GetManagedFields() {
+		if entry.Manager == util.FieldManager && entry.Operation == metav1.ManagedFieldsOperationApply {
+			return true
+		}
+	}
+	return false
+}
+
 func (d *managedDispatcherImpl) Delete(clusterName string, opts ...runtimeclient.DeleteOption) {
 	d.RecordStatus(clusterName, status.DeletionTimedOut, nil)
 
@@ -274,6 +398,11 @@ func (d *managedDispatcherImpl) RecordStatus(clusterName string, propStatus stat
 }
 
 func (d *managedDispatcherImpl) recordOperationError(propStatus status.PropagationStatus, clusterName, operation string, err error) util.ReconciliationStatus {
+	if apierrors.IsTooManyRequests(err) && d.clusterBackoff != nil {
+		delay := d.clusterBackoff.Throttled(clusterName, err)
+		klog.V(2).Infof("Cluster %q throttled the %s of %s %q, backing off for %v", clusterName, operation, d.fedResource.TargetKind(), d.fedResource.TargetName(), delay)
+		propStatus = status.ClusterThrottled
+	}
 	d.recordError(clusterName, operation, err)
 	d.RecordStatus(clusterName, propStatus, nil)
 	return util.StatusError
@@ -327,9 +456,14 @@ func (d *managedDispatcherImpl) CollectedStatus() (status.CollectedPropagationSt
 	for key, value := range d.resourceStatusMap {
 		resourceStatusMap[key] = value
 	}
+	driftMap := make(map[string][]string)
+	for key, value := range d.driftMap {
+		driftMap[key] = value
+	}
 	return status.CollectedPropagationStatus{
 			StatusMap:        statusMap,
 			ResourcesUpdated: d.resourcesUpdated,
+			DriftMap:         driftMap,
 		}, status.CollectedResourceStatus{
 			StatusMap:        resourceStatusMap,
 			ResourcesUpdated: d.resourcesUpdated,
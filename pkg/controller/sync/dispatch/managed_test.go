@@ -0,0 +1,99 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dispatch
+
+import (
+	"context"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	runtimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+
+	"sigs.k8s.io/kubefed/pkg/client/generic"
+	"sigs.k8s.io/kubefed/pkg/controller/util"
+)
+
+func TestApplyObject(t *testing.T) {
+	testCases := map[string]struct {
+		managedFields []metav1.ManagedFieldsEntry
+		create        bool
+		expectedForce bool
+	}{
+		"Creation does not force ownership": {
+			create: true,
+		},
+		"Object last updated before server-side apply was enabled has ownership forced": {
+			managedFields: []metav1.ManagedFieldsEntry{
+				{Manager: "controller-manager", Operation: metav1.ManagedFieldsOperationUpdate},
+			},
+			expectedForce: true,
+		},
+		"Object without managed fields has ownership forced": {
+			expectedForce: true,
+		},
+		"Object previously applied does not force ownership": {
+			managedFields: []metav1.ManagedFieldsEntry{
+				{Manager: "controller-manager", Operation: metav1.ManagedFieldsOperationUpdate},
+				{Manager: util.FieldManager, Operation: metav1.ManagedFieldsOperationApply},
+				{Manager: "kubectl", Operation: metav1.ManagedFieldsOperationApply},
+			},
+		},
+	}
+
+	for testName, tc := range testCases {
+		t.Run(testName, func(t *testing.T) {
+			obj := &unstructured.Unstructured{}
+			obj.SetAPIVersion("v1")
+			obj.SetKind("ConfigMap")
+			obj.SetName("foo")
+			var clusterObj *unstructured.Unstructured
+			if !tc.create {
+				clusterObj = obj.DeepCopy()
+				clusterObj.SetManagedFields(tc.managedFields)
+			}
+
+			client := &fakeApplyClient{}
+			if err := applyObject(client, obj, clusterObj); err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if client.patchOptions == nil {
+				t.Fatalf("Expected the object to be applied")
+			}
+			if client.patchOptions.FieldManager != util.FieldManager {
+				t.Fatalf("Expected field manager %q, got %q", util.FieldManager, client.patchOptions.FieldManager)
+			}
+			force := client.patchOptions.Force != nil && *client.patchOptions.Force
+			if force != tc.expectedForce {
+				t.Fatalf("Expected force to be %v, got %v", tc.expectedForce, force)
+			}
+		})
+	}
+}
+
+// fakeApplyClient records the options of the last patch.
+type fakeApplyClient struct {
+	generic.Client
+
+	patchOptions *runtimeclient.PatchOptions
+}
+
+func (c *fakeApplyClient) Patch(ctx context.Context, obj runtimeclient.Object, patch runtimeclient.Patch, opts ...runtimeclient.PatchOption) error {
+	c.patchOptions = &runtimeclient.PatchOptions{}
+	c.patchOptions.ApplyOptions(opts)
+	return nil
+}
@@ -17,6 +17,7 @@ limitations under the License.
 package dispatch
 
 import (
+	"sync"
 	"sync/atomic"
 	"time"
 
@@ -45,6 +46,27 @@ type OperationDispatcher interface {
 	Wait() (ok bool, timeoutErr error)
 }
 
+// OperationOptions configures the operations dispatched to member
+// clusters.
+type OperationOptions struct {
+	// Timeout is the time to wait for all operations to complete.
+	Timeout time.Duration
+	// MaxInFlight is the maximum number of operations that may be
+	// executing at once. A value of 0 does not limit the number of
+	// operations.
+	MaxInFlight int64
+	// ServerSideApply indicates whether managed resources are
+	// created and updated with server-side apply.
+	ServerSideApply bool
+	// ReportOnly indicates whether managed resources that differ
+	// from the desired state are reported as drifted instead of
+	// being updated.
+	ReportOnly bool
+	// ClusterBackoff records the member clusters that throttled
+	// operations. Throttling is not recorded if nil.
+	ClusterBackoff *util.ClusterBackoff
+}
+
 type operationDispatcherImpl struct {
 	clientAccessor clientAccessorFunc
 
@@ -52,26 +74,50 @@ type operationDispatcherImpl struct {
 	operationsInitiated int32
 
 	timeout time.Duration
+	// The time by which all operations must complete, determined
+	// when the dispatcher is created so that the timeout bounds both
+	// dispatching operations and waiting for them.
+	deadline time.Time
+
+	// Limits the number of operations in flight. Nil if the number
+	// of operations is not limited.
+	inFlight chan struct{}
+
+	// Closed on timeout to release operations that are still
+	// waiting to report their result.
+	stopChan chan struct{}
+	stopOnce sync.Once
 
 	recorder dispatchRecorder
 }
 
-func newOperationDispatcher(clientAccessor clientAccessorFunc, recorder dispatchRecorder) *operationDispatcherImpl {
-	return &operationDispatcherImpl{
+func newOperationDispatcher(clientAccessor clientAccessorFunc, recorder dispatchRecorder, options OperationOptions) *operationDispatcherImpl {
+	d := &operationDispatcherImpl{
 		clientAccessor: clientAccessor,
 		resultChan:     make(chan util.ReconciliationStatus),
-		timeout:        30 * time.Second, // TODO(marun) Make this configurable
+		timeout:        options.Timeout,
+		deadline:       time.Now().Add(options.Timeout),
+		stopChan:       make(chan struct{}),
 		recorder:       recorder,
 	}
+	if options.MaxInFlight > 0 {
+		d.inFlight = make(chan struct{}, options.MaxInFlight)
+	}
+	return d
+}
+
+func (d *operationDispatcherImpl) stop() {
+	d.stopOnce.Do(func() {
+		close(d.stopChan)
+	})
 }
 
 func (d *operationDispatcherImpl) Wait() (bool, error) {
 	ok := true
 	timedOut := false
-	start := time.Now()
 	for i := int32(0); i < atomic.LoadInt32(&d.operationsInitiated); i++ {
 		now := time.Now()
-		if !now.Before(start.Add(d.timeout)) {
+		if !now.Before(d.deadline) {
 			timedOut = true
 			break
 		}
@@ -81,18 +127,55 @@ func (d *operationDispatcherImpl) Wait() (bool, error) {
 				ok = false
 			}
 			break
-		case <-time.After(start.Add(d.timeout).Sub(now)):
+		case <-time.After(d.deadline.Sub(now)):
+			timedOut = true
+			break
+		case <-d.stopChan:
+			// An operation could not be dispatched in time.
 			timedOut = true
 			break
 		}
+		if timedOut {
+			break
+		}
 	}
 	if timedOut {
+		d.stop()
 		return false, errors.Errorf("Failed to finish %d operations in %v", atomic.LoadInt32(&d.operationsInitiated), d.timeout)
 	}
 	return ok, nil
 }
 
+// clusterOperation executes the given operation in a new goroutine.
+// If the number of operations in flight is limited, the goroutine is
+// only started once the operation may execute, blocking the caller
+// until then. An operation that cannot be dispatched before the
+// timeout is abandoned, leaving the timeout status recorded for the
+// cluster, and causes Wait to time out.
 func (d *operationDispatcherImpl) clusterOperation(clusterName, op string, opFunc func(generic.Client) util.ReconciliationStatus) {
+	if d.inFlight != nil {
+		select {
+		case d.inFlight <- struct{}{}:
+		case <-d.stopChan:
+			return
+		case <-time.After(time.Until(d.deadline)):
+			d.stop()
+			return
+		}
+	}
+	go func() {
+		result := d.executeOperation(clusterName, op, opFunc)
+		if d.inFlight != nil {
+			<-d.inFlight
+		}
+		select {
+		case d.resultChan <- result:
+		case <-d.stopChan:
+		}
+	}()
+}
+
+func (d *operationDispatcherImpl) executeOperation(clusterName, op string, opFunc func(generic.Client) util.ReconciliationStatus) util.ReconciliationStatus {
 	// TODO(marun) Support cancellation of client calls on timeout.
 	client, err := d.clientAccessor(clusterName)
 	if err != nil {
@@ -102,13 +185,11 @@ func (d *operationDispatcherImpl) clusterOperation(clusterName, op string, opFun
 		} else {
 			d.recorder.recordOperationError(status.ClientRetrievalFailed, clusterName, op, wrappedErr)
 		}
-		d.resultChan <- util.StatusError
-		return
+		return util.StatusError
 	}
 
 	// TODO(marun) Retry on recoverable errors (e.g. IsConflict, AlreadyExists)
-	ok := opFunc(client)
-	d.resultChan <- ok
+	return opFunc(client)
 }
 
 func (d *operationDispatcherImpl) incrementOperationsInitiated() {
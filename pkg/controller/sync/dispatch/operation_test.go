@@ -0,0 +1,160 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dispatch
+
+import (
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"sigs.k8s.io/kubefed/pkg/client/generic"
+	"sigs.k8s.io/kubefed/pkg/controller/util"
+)
+
+func nilClientAccessor(string) (generic.Client, error) {
+	return nil, nil
+}
+
+func TestOperationDispatcherMaxInFlight(t *testing.T) {
+	testCases := map[string]struct {
+		maxInFlight         int64
+		expectedMaxInFlight int32
+	}{
+		"operations are not limited when maxInFlight is 0": {
+			maxInFlight:         0,
+			expectedMaxInFlight: 5,
+		},
+		"operations are limited to maxInFlight": {
+			maxInFlight:         2,
+			expectedMaxInFlight: 2,
+		},
+	}
+
+	for testName, testCase := range testCases {
+		t.Run(testName, func(t *testing.T) {
+			d := newOperationDispatcher(nilClientAccessor, nil, OperationOptions{
+				Timeout:     10 * time.Second,
+				MaxInFlight: testCase.maxInFlight,
+			})
+
+			var inFlight, maxInFlight, dispatched int32
+			release := make(chan struct{})
+			for i := 0; i < 5; i++ {
+				d.incrementOperationsInitiated()
+			}
+			// Operations are dispatched by a separate goroutine since
+			// dispatching blocks while the number of operations in
+			// flight is limited.
+			go func() {
+				for i := 0; i < 5; i++ {
+					d.clusterOperation("cluster", "test", func(generic.Client) util.ReconciliationStatus {
+						current := atomic.AddInt32(&inFlight, 1)
+						for {
+							observed := atomic.LoadInt32(&maxInFlight)
+							if current <= observed || atomic.CompareAndSwapInt32(&maxInFlight, observed, current) {
+								break
+							}
+						}
+						<-release
+						atomic.AddInt32(&inFlight, -1)
+						return util.StatusAllOK
+					})
+					atomic.AddInt32(&dispatched, 1)
+				}
+			}()
+
+			// Allow the operations to start before releasing them.
+			time.Sleep(100 * time.Millisecond)
+			// No goroutine should be started for an operation that
+			// cannot execute yet.
+			if dispatched := atomic.LoadInt32(&dispatched); dispatched != testCase.expectedMaxInFlight {
+				t.Fatalf("Expected %d operations to be dispatched, got %d", testCase.expectedMaxInFlight, dispatched)
+			}
+			close(release)
+
+			ok, err := d.Wait()
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if !ok {
+				t.Fatalf("Expected all operations to succeed")
+			}
+			if maxInFlight != testCase.expectedMaxInFlight {
+				t.Fatalf("Expected %d operations in flight, got %d", testCase.expectedMaxInFlight, maxInFlight)
+			}
+		})
+	}
+}
+
+func TestOperationDispatcherTimeout(t *testing.T) {
+	d := newOperationDispatcher(nilClientAccessor, nil, OperationOptions{
+		Timeout:     50 * time.Millisecond,
+		MaxInFlight: 1,
+	})
+
+	var executed int32
+	release := make(chan struct{})
+	for i := 0; i < 2; i++ {
+		d.incrementOperationsInitiated()
+		d.clusterOperation("cluster", "test", func(generic.Client) util.ReconciliationStatus {
+			atomic.AddInt32(&executed, 1)
+			<-release
+			return util.StatusAllOK
+		})
+	}
+
+	// The second operation cannot be dispatched before the timeout.
+	_, err := d.Wait()
+	if err == nil {
+		t.Fatalf("Expected a timeout error")
+	}
+
+	// The operation waiting for the one in flight should be
+	// abandoned rather than executed once it completes.
+	close(release)
+	time.Sleep(50 * time.Millisecond)
+	if executed := atomic.LoadInt32(&executed); executed != 1 {
+		t.Fatalf("Expected 1 operation to execute, got %d", executed)
+	}
+}
+
+func TestOperationDispatcherTimeoutIncludesDispatch(t *testing.T) {
+	timeout := 200 * time.Millisecond
+	start := time.Now()
+	d := newOperationDispatcher(nilClientAccessor, nil, OperationOptions{
+		Timeout: timeout,
+	})
+
+	// Dispatching operations takes up most of the timeout.
+	time.Sleep(150 * time.Millisecond)
+	release := make(chan struct{})
+	defer close(release)
+	d.incrementOperationsInitiated()
+	d.clusterOperation("cluster", "test", func(generic.Client) util.ReconciliationStatus {
+		<-release
+		return util.StatusAllOK
+	})
+
+	_, err := d.Wait()
+	if err == nil {
+		t.Fatalf("Expected a timeout error")
+	}
+	// Wait should only wait for the remainder of the timeout.
+	if elapsed := time.Since(start); elapsed >= timeout+100*time.Millisecond {
+		t.Fatalf("Expected the operations to time out after %v, took %v", timeout, elapsed)
+	}
+}
@@ -20,13 +20,34 @@ import (
 	"github.com/pkg/errors"
 
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
 
+	fedv1b1 "sigs.k8s.io/kubefed/pkg/apis/core/v1beta1"
 	"sigs.k8s.io/kubefed/pkg/controller/util"
+	"sigs.k8s.io/kubefed/pkg/interpreter"
 )
 
+// RetainFieldsForUpdate updates the desired object for the given
+// federated resource with the values retained from the cluster object
+// when updating the cluster object.
+func RetainFieldsForUpdate(fedResource FederatedResourceForDispatch, desiredObj, clusterObj *unstructured.Unstructured, serverSideApply bool) error {
+	if serverSideApply {
+		// Fields that are not applied are left to the member
+		// cluster, so only the replicas field and the fields
+		// configured for the type need retaining.
+		err := retainReplicas(fedResource.Interpreter(), desiredObj, clusterObj, fedResource.Object())
+		if err != nil {
+			return err
+		}
+		return retainFields(desiredObj, clusterObj, fedResource.RetainedFields())
+	}
+	return RetainClusterFields(fedResource.Interpreter(), desiredObj, clusterObj, fedResource.Object(), fedResource.RetainedFields())
+}
+
 // RetainClusterFields updates the desired object with values retained
-// from the cluster object.
-func RetainClusterFields(targetKind string, desiredObj, clusterObj, fedObj *unstructured.Unstructured) error {
+// from the cluster object. The given fields are retained in addition to
+// those retained by the interpreter of the target type.
+func RetainClusterFields(interp interpreter.Interpreter, desiredObj, clusterObj, fedObj *unstructured.Unstructured, retainedFields []fedv1b1.RetainedField) error {
 	// Pass the same ResourceVersion as in the cluster object for update operation, otherwise operation will fail.
 	desiredObj.SetResourceVersion(clusterObj.GetResourceVersion())
 
@@ -36,125 +57,50 @@ func RetainClusterFields(targetKind string, desiredObj, clusterObj, fedObj *unst
 	desiredObj.SetFinalizers(clusterObj.GetFinalizers())
 	desiredObj.SetAnnotations(clusterObj.GetAnnotations())
 
-	if targetKind == util.ServiceKind {
-		return retainServiceFields(desiredObj, clusterObj)
+	if err := interp.Retain(desiredObj, clusterObj); err != nil {
+		return err
 	}
-	if targetKind == util.ServiceAccountKind {
-		return retainServiceAccountFields(desiredObj, clusterObj)
+	if err := retainReplicas(interp, desiredObj, clusterObj, fedObj); err != nil {
+		return err
 	}
-	return retainReplicas(desiredObj, clusterObj, fedObj)
+	return retainFields(desiredObj, clusterObj, retainedFields)
 }
 
-func retainServiceFields(desiredObj, clusterObj *unstructured.Unstructured) error {
-	// healthCheckNodePort is allocated by APIServer and unchangeable, so it should be retained while updating
-	healthCheckNodePort, ok, err := unstructured.NestedInt64(clusterObj.Object, util.SpecField, util.HealthCheckNodePortField)
-	if err != nil {
-		return errors.Wrap(err, "Error retrieving healthCheckNodePort from service")
-	}
-	if ok && healthCheckNodePort > 0 {
-		if err = unstructured.SetNestedField(desiredObj.Object, healthCheckNodePort, util.SpecField, util.HealthCheckNodePortField); err != nil {
-			return errors.Wrap(err, "Error setting healthCheckNodePort for service")
+// retainFields retains the values of the given fields of the cluster
+// object. A field that is not set in the cluster object is left as is
+// in the desired object.
+func retainFields(desiredObj, clusterObj *unstructured.Unstructured, retainedFields []fedv1b1.RetainedField) error {
+	for i := range retainedFields {
+		retainedField := &retainedFields[i]
+		fields := retainedField.Fields()
+		if len(fields) == 0 {
+			return errors.Errorf("Invalid path %q of retained field", retainedField.Path)
 		}
-	}
-
-	// ClusterIP and NodePort are allocated to Service by cluster, so retain the same if any while updating
-
-	// Retain clusterip and clusterips
-	clusterIP, ok, err := unstructured.NestedString(clusterObj.Object, util.SpecField, util.ClusterIPField)
-	if err != nil {
-		return errors.Wrap(err, "Error retrieving clusterIP from cluster service")
-	}
-	// !ok could indicate that a cluster ip was not assigned
-	if ok && clusterIP != "" {
-		err := unstructured.SetNestedField(desiredObj.Object, clusterIP, util.SpecField, util.ClusterIPField)
+		value, ok, err := unstructured.NestedFieldNoCopy(clusterObj.Object, fields...)
 		if err != nil {
-			return errors.Wrap(err, "Error setting clusterIP for service")
+			return errors.Wrapf(err, "Error retrieving %q from cluster object", retainedField.Path)
 		}
-	}
-	clusterIPs, ok, err := unstructured.NestedStringSlice(clusterObj.Object, util.SpecField, util.ClusterIPsField)
-	if err != nil {
-		return errors.Wrap(err, "Error retrieving clusterIPs from cluster service")
-	}
-	// !ok could indicate that cluster ips was not assigned
-	if ok && len(clusterIPs) > 0 {
-		err := unstructured.SetNestedStringSlice(desiredObj.Object, clusterIPs, util.SpecField, util.ClusterIPsField)
-		if err != nil {
-			return errors.Wrap(err, "Error setting clusterIPs for service")
+		if !ok {
+			continue
 		}
-	}
-
-	// Retain nodeports
-	clusterPorts, ok, err := unstructured.NestedSlice(clusterObj.Object, util.SpecField, util.PortsField)
-	if err != nil {
-		return errors.Wrap(err, "Error retrieving ports from cluster service")
-	}
-	if !ok {
-		return nil
-	}
-	var desiredPorts []interface{}
-	desiredPorts, ok, err = unstructured.NestedSlice(desiredObj.Object, util.SpecField, util.PortsField)
-	if err != nil {
-		return errors.Wrap(err, "Error retrieving ports from service")
-	}
-	if !ok {
-		desiredPorts = []interface{}{}
-	}
-	for desiredIndex := range desiredPorts {
-		for clusterIndex := range clusterPorts {
-			fPort := desiredPorts[desiredIndex].(map[string]interface{})
-			cPort := clusterPorts[clusterIndex].(map[string]interface{})
-			if !(fPort["name"] == cPort["name"] && fPort["protocol"] == cPort["protocol"] && fPort["port"] == cPort["port"]) {
-				continue
+		if retainedField.Mode == fedv1b1.RetainIfUnset {
+			_, ok, err := unstructured.NestedFieldNoCopy(desiredObj.Object, fields...)
+			if err != nil {
+				return errors.Wrapf(err, "Error retrieving %q from desired object", retainedField.Path)
 			}
-			nodePort, ok := cPort["nodePort"]
 			if ok {
-				fPort["nodePort"] = nodePort
+				continue
 			}
 		}
-	}
-	err = unstructured.SetNestedSlice(desiredObj.Object, desiredPorts, util.SpecField, util.PortsField)
-	if err != nil {
-		return errors.Wrap(err, "Error setting ports for service")
-	}
-
-	return nil
-}
-
-// retainServiceAccountFields retains the 'secrets' field of a service account
-// if the desired representation does not include a value for the field.  This
-// ensures that the sync controller doesn't continually clear a generated
-// secret from a service account, prompting continual regeneration by the
-// service account controller in the member cluster.
-//
-// TODO(marun) Clearing a manually-set secrets field will require resetting
-// placement.  Is there a better way to do this?
-func retainServiceAccountFields(desiredObj, clusterObj *unstructured.Unstructured) error {
-	// Check whether the secrets field is populated in the desired object.
-	desiredSecrets, ok, err := unstructured.NestedSlice(desiredObj.Object, util.SecretsField)
-	if err != nil {
-		return errors.Wrap(err, "Error retrieving secrets from desired service account")
-	}
-	if ok && len(desiredSecrets) > 0 {
-		// Field is populated, so an update to the target resource does not
-		// risk triggering a race with the service account controller.
-		return nil
-	}
-
-	// Retrieve the secrets from the cluster object and retain them.
-	secrets, ok, err := unstructured.NestedSlice(clusterObj.Object, util.SecretsField)
-	if err != nil {
-		return errors.Wrap(err, "Error retrieving secrets from service account")
-	}
-	if ok && len(secrets) > 0 {
-		err := unstructured.SetNestedField(desiredObj.Object, secrets, util.SecretsField)
+		err = unstructured.SetNestedField(desiredObj.Object, runtime.DeepCopyJSONValue(value), fields...)
 		if err != nil {
-			return errors.Wrap(err, "Error setting secrets for service account")
+			return errors.Wrapf(err, "Error setting %q in desired object", retainedField.Path)
 		}
 	}
 	return nil
 }
 
-func retainReplicas(desiredObj, clusterObj, fedObj *unstructured.Unstructured) error {
+func retainReplicas(interp interpreter.Interpreter, desiredObj, clusterObj, fedObj *unstructured.Unstructured) error {
 	// Retain the replicas field if the federated object has been
 	// configured to do so.  If the replicas field is intended to be
 	// set by the in-cluster HPA controller, not retaining it will
@@ -164,12 +110,12 @@ func retainReplicas(desiredObj, clusterObj, fedObj *unstructured.Unstructured) e
 		return err
 	}
 	if ok && retainReplicas {
-		replicas, ok, err := unstructured.NestedInt64(clusterObj.Object, util.SpecField, util.ReplicasField)
+		replicas, _, ok, err := interp.GetReplicas(clusterObj)
 		if err != nil {
 			return err
 		}
 		if ok {
-			err := unstructured.SetNestedField(desiredObj.Object, replicas, util.SpecField, util.ReplicasField)
+			err := interp.SetReplicas(desiredObj, replicas)
 			if err != nil {
 				return err
 			}
@@ -21,9 +21,11 @@ import (
 	"testing"
 
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
-	"k8s.io/utils/pointer"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 
+	fedv1b1 "sigs.k8s.io/kubefed/pkg/apis/core/v1beta1"
 	"sigs.k8s.io/kubefed/pkg/controller/util"
+	"sigs.k8s.io/kubefed/pkg/interpreter"
 )
 
 func TestRetainClusterFields(t *testing.T) {
@@ -70,7 +72,7 @@ func TestRetainClusterFields(t *testing.T) {
 					},
 				},
 			}
-			if err := RetainClusterFields("", desiredObj, clusterObj, fedObj); err != nil {
+			if err := RetainClusterFields(interpreter.ForGroupKind(schema.GroupKind{Group: "apps", Kind: "Deployment"}), desiredObj, clusterObj, fedObj, nil); err != nil {
 				t.Fatalf("Unexpected error: %v", err)
 			}
 
@@ -88,204 +90,62 @@ func TestRetainClusterFields(t *testing.T) {
 	}
 }
 
-func TestRetainHealthCheckNodePortInServiceFields(t *testing.T) {
-	tests := []struct {
-		name          string
-		desiredObj    *unstructured.Unstructured
-		clusterObj    *unstructured.Unstructured
-		retainSucceed bool
-		expectedValue *int64
-	}{
-		{
-			"cluster object has no healthCheckNodePort",
-			&unstructured.Unstructured{
-				Object: map[string]interface{}{},
-			},
-			&unstructured.Unstructured{
-				Object: map[string]interface{}{},
-			},
-			true,
-			nil,
-		},
-		{
-			"cluster object has invalid healthCheckNodePort",
-			&unstructured.Unstructured{
-				Object: map[string]interface{}{},
-			},
-			&unstructured.Unstructured{
-				Object: map[string]interface{}{
-					"spec": map[string]interface{}{
-						"healthCheckNodePort": "invalid string",
-					},
-				},
-			},
-			false,
-			nil,
-		},
-		{
-			"cluster object has healthCheckNodePort 0",
-			&unstructured.Unstructured{
-				Object: map[string]interface{}{},
-			},
-			&unstructured.Unstructured{
-				Object: map[string]interface{}{
-					"spec": map[string]interface{}{
-						"healthCheckNodePort": int64(0),
-					},
-				},
-			},
-			true,
-			nil,
-		},
-		{
-			"cluster object has healthCheckNodePort 1000",
-			&unstructured.Unstructured{
-				Object: map[string]interface{}{},
-			},
-			&unstructured.Unstructured{
-				Object: map[string]interface{}{
-					"spec": map[string]interface{}{
-						"healthCheckNodePort": int64(1000),
-					},
-				},
-			},
-			true,
-			pointer.Int64Ptr(1000),
-		},
-	}
-	for _, test := range tests {
-		t.Run(test.name, func(t *testing.T) {
-			if err := retainServiceFields(test.desiredObj, test.clusterObj); (err == nil) != test.retainSucceed {
-				t.Fatalf("test %s fails: unexpected returned error %v", test.name, err)
-			}
-
-			currentValue, ok, err := unstructured.NestedInt64(test.desiredObj.Object, "spec", "healthCheckNodePort")
-			if err != nil {
-				t.Fatalf("test %s fails: %v", test.name, err)
-			}
-			if !ok && test.expectedValue != nil {
-				t.Fatalf("test %s fails: expect specified healthCheckNodePort but not found", test.name)
-			}
-			if ok && (test.expectedValue == nil || *test.expectedValue != currentValue) {
-				t.Fatalf("test %s fails: unexpected current healthCheckNodePort %d", test.name, currentValue)
-			}
-		})
-	}
-}
-
-func TestRetainClusterIPsInServiceFields(t *testing.T) {
-	tests := []struct {
-		name                    string
-		desiredObj              *unstructured.Unstructured
-		clusterObj              *unstructured.Unstructured
-		retainSucceed           bool
-		expectedClusterIPValue  *string
-		expectedClusterIPsValue []string
+func TestRetainFields(t *testing.T) {
+	testCases := map[string]struct {
+		retainedFields []fedv1b1.RetainedField
+		desiredSpec    map[string]interface{}
+		clusterSpec    map[string]interface{}
+		expectedSpec   map[string]interface{}
 	}{
-		{
-			"cluster object has no clusterIP or clusterIPs",
-			&unstructured.Unstructured{
-				Object: map[string]interface{}{},
-			},
-			&unstructured.Unstructured{
-				Object: map[string]interface{}{},
-			},
-			true,
-			nil,
-			nil,
+		"field is retained from the cluster object": {
+			retainedFields: []fedv1b1.RetainedField{{Path: "/spec/host"}},
+			desiredSpec:    map[string]interface{}{"host": "desired"},
+			clusterSpec:    map[string]interface{}{"host": "cluster"},
+			expectedSpec:   map[string]interface{}{"host": "cluster"},
 		},
-		{
-			"cluster object has clusterIP",
-			&unstructured.Unstructured{
-				Object: map[string]interface{}{},
+		"nested field is retained when unset in the desired object": {
+			retainedFields: []fedv1b1.RetainedField{{Path: "/spec/tls/secret~1name"}},
+			desiredSpec:    map[string]interface{}{},
+			clusterSpec: map[string]interface{}{
+				"tls": map[string]interface{}{"secret/name": "cluster"},
 			},
-			&unstructured.Unstructured{
-				Object: map[string]interface{}{
-					"spec": map[string]interface{}{
-						"clusterIP": -1000,
-					},
-				},
+			expectedSpec: map[string]interface{}{
+				"tls": map[string]interface{}{"secret/name": "cluster"},
 			},
-			false,
-			nil,
-			nil,
 		},
-		{
-			"cluster object has clusterIP only",
-			&unstructured.Unstructured{
-				Object: map[string]interface{}{},
-			},
-			&unstructured.Unstructured{
-				Object: map[string]interface{}{
-					"spec": map[string]interface{}{
-						"clusterIP": "1.2.3.4",
-					},
-				},
-			},
-			true,
-			pointer.String("1.2.3.4"),
-			nil,
+		"field unset in the cluster object is not retained": {
+			retainedFields: []fedv1b1.RetainedField{{Path: "/spec/host"}},
+			desiredSpec:    map[string]interface{}{"host": "desired"},
+			clusterSpec:    map[string]interface{}{},
+			expectedSpec:   map[string]interface{}{"host": "desired"},
 		},
-		{
-			"cluster object has clusterIPs only",
-			&unstructured.Unstructured{
-				Object: map[string]interface{}{},
-			},
-			&unstructured.Unstructured{
-				Object: map[string]interface{}{
-					"spec": map[string]interface{}{
-						"clusterIPs": []interface{}{"1.2.3.4", "5.6.7.8"},
-					},
-				},
-			},
-			true,
-			nil,
-			[]string{"1.2.3.4", "5.6.7.8"},
+		"field set in the desired object is not retained if unset is required": {
+			retainedFields: []fedv1b1.RetainedField{{Path: "/spec/host", Mode: fedv1b1.RetainIfUnset}},
+			desiredSpec:    map[string]interface{}{"host": "desired"},
+			clusterSpec:    map[string]interface{}{"host": "cluster"},
+			expectedSpec:   map[string]interface{}{"host": "desired"},
 		},
-		{
-			"cluster object has both clusterIP and clusterIPs",
-			&unstructured.Unstructured{
-				Object: map[string]interface{}{},
-			},
-			&unstructured.Unstructured{
-				Object: map[string]interface{}{
-					"spec": map[string]interface{}{
-						"clusterIP":  "1.2.3.4",
-						"clusterIPs": []interface{}{"5.6.7.8", "9.10.11.12"},
-					},
-				},
-			},
-			true,
-			pointer.String("1.2.3.4"),
-			[]string{"5.6.7.8", "9.10.11.12"},
+		"field unset in the desired object is retained if unset is required": {
+			retainedFields: []fedv1b1.RetainedField{{Path: "/spec/host", Mode: fedv1b1.RetainIfUnset}},
+			desiredSpec:    map[string]interface{}{"port": int64(80)},
+			clusterSpec:    map[string]interface{}{"host": "cluster"},
+			expectedSpec:   map[string]interface{}{"host": "cluster", "port": int64(80)},
 		},
 	}
-	for _, test := range tests {
-		t.Run(test.name, func(t *testing.T) {
-			if err := retainServiceFields(test.desiredObj, test.clusterObj); (err == nil) != test.retainSucceed {
-				t.Fatalf("test %s fails: unexpected returned error %v", test.name, err)
-			}
 
-			currentClusterIPValue, ok, err := unstructured.NestedString(test.desiredObj.Object, "spec", "clusterIP")
-			if err != nil {
-				t.Fatalf("test %s fails: %v", test.name, err)
-			}
-			if !ok && test.expectedClusterIPValue != nil {
-				t.Fatalf("test %s fails: expect specified clusterIP but not found", test.name)
-			}
-			if ok && (test.expectedClusterIPValue == nil || *test.expectedClusterIPValue != currentClusterIPValue) {
-				t.Fatalf("test %s fails: unexpected current clusterIP %s", test.name, currentClusterIPValue)
+	for testName, testCase := range testCases {
+		t.Run(testName, func(t *testing.T) {
+			desiredObj := &unstructured.Unstructured{
+				Object: map[string]interface{}{"spec": testCase.desiredSpec},
 			}
-
-			currentClusterIPsValue, ok, err := unstructured.NestedStringSlice(test.desiredObj.Object, "spec", "clusterIPs")
-			if err != nil {
-				t.Fatalf("test %s fails: %v", test.name, err)
+			clusterObj := &unstructured.Unstructured{
+				Object: map[string]interface{}{"spec": testCase.clusterSpec},
 			}
-			if !ok && test.expectedClusterIPsValue != nil {
-				t.Fatalf("test %s fails: expect specified clusterIPs but not found", test.name)
+			if err := retainFields(desiredObj, clusterObj, testCase.retainedFields); err != nil {
+				t.Fatalf("Unexpected error: %v", err)
 			}
-			if ok && !reflect.DeepEqual(test.expectedClusterIPsValue, currentClusterIPsValue) {
-				t.Fatalf("test %s fails: unexpected current clusterIPs %v", test.name, currentClusterIPsValue)
+			if !reflect.DeepEqual(desiredObj.Object["spec"], testCase.expectedSpec) {
+				t.Fatalf("Expected spec %v, got %v", testCase.expectedSpec, desiredObj.Object["spec"])
 			}
 		})
 	}
@@ -0,0 +1,65 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sync
+
+import (
+	"github.com/pkg/errors"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	fedv1b1 "sigs.k8s.io/kubefed/pkg/apis/core/v1beta1"
+	"sigs.k8s.io/kubefed/pkg/controller/util"
+	"sigs.k8s.io/kubefed/pkg/interpreter"
+)
+
+// computeHealth determines the health of the resources of the given
+// federated resource in the selected clusters. A resource in a
+// cluster that is not ready is degraded, and a resource that has not
+// been created yet is progressing. The health of the resources that
+// exist in member clusters is interpreted at once. If it cannot be
+// determined, the resources are reported as progressing and the
+// error is returned.
+func (s *KubeFedSyncController) computeHealth(fedResource FederatedResource, clusters []*fedv1b1.KubeFedCluster, selectedClusterNames sets.String) (map[string]util.ResourceHealth, error) {
+	key := fedResource.TargetName().String()
+	health := make(map[string]util.ResourceHealth)
+	clusterObjs := make(map[string]*unstructured.Unstructured)
+	for _, cluster := range clusters {
+		clusterName := cluster.Name
+		if !selectedClusterNames.Has(clusterName) {
+			continue
+		}
+		if !util.IsClusterReady(&cluster.Status) {
+			health[clusterName] = util.ResourceDegraded
+			continue
+		}
+		health[clusterName] = util.ResourceProgressing
+		rawClusterObj, _, err := s.informer.GetTargetStore().GetByKey(clusterName, key)
+		if err != nil || rawClusterObj == nil {
+			continue
+		}
+		clusterObjs[clusterName] = rawClusterObj.(*unstructured.Unstructured)
+	}
+	clusterHealth, err := interpreter.HealthForClusters(fedResource.Interpreter(), clusterObjs)
+	if err != nil {
+		return health, errors.Wrapf(err, "failed to determine health of %s %q", fedResource.TargetKind(), key)
+	}
+	for clusterName, value := range clusterHealth {
+		health[clusterName] = value
+	}
+	return health, nil
+}
@@ -0,0 +1,199 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sync
+
+import (
+	"fmt"
+
+	"github.com/pkg/errors"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	"sigs.k8s.io/kubefed/pkg/apis/core/typeconfig"
+	fedv1b1 "sigs.k8s.io/kubefed/pkg/apis/core/v1beta1"
+	policyv1a1 "sigs.k8s.io/kubefed/pkg/apis/policy/v1alpha1"
+	"sigs.k8s.io/kubefed/pkg/controller/sync/dispatch"
+	"sigs.k8s.io/kubefed/pkg/controller/util"
+	"sigs.k8s.io/kubefed/pkg/interpreter"
+)
+
+// RenderInput is the state that determines the resources propagated
+// to member clusters for a federated resource.
+type RenderInput struct {
+	// The federated resource to render.
+	Object *unstructured.Unstructured
+	// The type config of the federated resource.
+	TypeConfig typeconfig.Interface
+	// The member clusters to compute placement for.
+	Clusters []*fedv1b1.KubeFedCluster
+	// The federated namespace containing a namespaced federated
+	// resource. Nil if the namespace is not federated.
+	FederatedNamespace *unstructured.Unstructured
+	// Whether the control plane is namespace-scoped.
+	LimitedScope bool
+
+	// Policies that do not apply to the federated resource are
+	// ignored.
+	PropagationPolicies        []*policyv1a1.PropagationPolicy
+	ClusterPropagationPolicies []*policyv1a1.ClusterPropagationPolicy
+	OverridePolicies           []*policyv1a1.OverridePolicy
+	ClusterOverridePolicies    []*policyv1a1.ClusterOverridePolicy
+
+	// The resources already present in member clusters, keyed by
+	// cluster name. Fields of these resources are retained as they
+	// would be when updating them. The resource for a cluster without
+	// an entry is rendered as it would be created.
+	ClusterObjects map[string]*unstructured.Unstructured
+}
+
+// RenderResult describes the resources propagated to member clusters
+// for a federated resource.
+type RenderResult struct {
+	// The placement decision made for each cluster.
+	PlacementDecisions util.PlacementDecisions
+	// The resources propagated to the selected clusters, keyed by
+	// cluster name.
+	Objects map[string]*unstructured.Unstructured
+	// Problems that would be reported as warning events for the
+	// federated resource, e.g. template fields that are not
+	// propagated.
+	Warnings []string
+}
+
+// Render computes the resources that the sync controller propagates
+// to member clusters for a federated resource. Placement, the
+// template, overrides and the retention of fields of existing
+// resources are applied as they are by the sync controller, but no
+// API is contacted.
+func Render(input RenderInput) (*RenderResult, error) {
+	obj := input.Object
+	typeConfig := input.TypeConfig
+	if obj == nil || typeConfig == nil {
+		return nil, errors.New("A federated resource and its type config are required")
+	}
+
+	federatedName := util.NewQualifiedName(obj)
+	targetName := federatedName
+	targetIsNamespace := typeConfig.GetTargetType().Kind == util.NamespaceKind
+	if targetIsNamespace {
+		targetName.Namespace = ""
+	}
+
+	namespace := obj.GetNamespace()
+	var propagationPolicies []*policyv1a1.PropagationPolicy
+	for _, policy := range input.PropagationPolicies {
+		if namespace != "" && policy.Namespace == namespace {
+			propagationPolicies = append(propagationPolicies, policy)
+		}
+	}
+	var overridePolicies []*policyv1a1.OverridePolicy
+	for _, policy := range input.OverridePolicies {
+		if namespace != "" && policy.Namespace == namespace {
+			overridePolicies = append(overridePolicies, policy)
+		}
+	}
+	policyOverrides, err := util.SelectOverridePolicies(obj, overridePolicies, input.ClusterOverridePolicies)
+	if err != nil {
+		return nil, err
+	}
+
+	interp, err := interpreter.ForTypeConfig(typeConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	recorder := &warningRecorder{}
+	fedResource := &federatedResource{
+		limitedScope:               input.LimitedScope,
+		typeConfig:                 typeConfig,
+		interpreter:                interp,
+		targetIsNamespace:          targetIsNamespace,
+		targetName:                 targetName,
+		federatedKind:              typeConfig.GetFederatedType().Kind,
+		federatedName:              federatedName,
+		federatedResource:          obj,
+		fedNamespace:               input.FederatedNamespace,
+		propagationPolicies:        propagationPolicies,
+		clusterPropagationPolicies: input.ClusterPropagationPolicies,
+		policyOverrides:            policyOverrides,
+		eventRecorder:              recorder,
+	}
+
+	selectedClusters, decisions, err := fedResource.ComputePlacementDecisions(input.Clusters)
+	if err != nil {
+		return nil, errors.Wrap(err, "Failed to compute placement")
+	}
+
+	objects := make(map[string]*unstructured.Unstructured)
+	for _, clusterName := range selectedClusters.List() {
+		clusterObj, err := fedResource.ObjectForCluster(clusterName)
+		if err != nil {
+			return nil, errors.Wrapf(err, "Failed to compute the resource for cluster %q", clusterName)
+		}
+		if existingObj, ok := input.ClusterObjects[clusterName]; ok {
+			err = dispatch.RetainFieldsForUpdate(fedResource, clusterObj, existingObj, typeConfig.GetServerSideApplyEnabled())
+			if err != nil {
+				return nil, errors.Wrapf(err, "Failed to retain fields for cluster %q", clusterName)
+			}
+		}
+		err = fedResource.ApplyOverrides(clusterObj, clusterName)
+		if err != nil {
+			return nil, errors.Wrapf(err, "Failed to apply overrides for cluster %q", clusterName)
+		}
+		objects[clusterName] = clusterObj
+	}
+
+	return &RenderResult{
+		PlacementDecisions: decisions,
+		Objects:            objects,
+		Warnings:           recorder.warnings,
+	}, nil
+}
+
+// warningRecorder records the messages of warning events instead of
+// sending events to an API. A message is only recorded once, even if
+// it is reported for each cluster.
+type warningRecorder struct {
+	warnings []string
+	recorded sets.String
+}
+
+func (r *warningRecorder) Event(object runtime.Object, eventtype, reason, message string) {
+	if eventtype != corev1.EventTypeWarning {
+		return
+	}
+	warning := fmt.Sprintf("%s: %s", reason, message)
+	if r.recorded == nil {
+		r.recorded = sets.NewString()
+	}
+	if r.recorded.Has(warning) {
+		return
+	}
+	r.recorded.Insert(warning)
+	r.warnings = append(r.warnings, warning)
+}
+
+func (r *warningRecorder) Eventf(object runtime.Object, eventtype, reason, messageFmt string, args ...interface{}) {
+	r.Event(object, eventtype, reason, fmt.Sprintf(messageFmt, args...))
+}
+
+func (r *warningRecorder) AnnotatedEventf(object runtime.Object, annotations map[string]string, eventtype, reason, messageFmt string, args ...interface{}) {
+	r.Eventf(object, eventtype, reason, messageFmt, args...)
+}
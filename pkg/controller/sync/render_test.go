@@ -0,0 +1,365 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sync
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	apiextv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	fedv1b1 "sigs.k8s.io/kubefed/pkg/apis/core/v1beta1"
+	"sigs.k8s.io/kubefed/pkg/controller/util"
+	"sigs.k8s.io/kubefed/pkg/interpreter"
+	kfenable "sigs.k8s.io/kubefed/pkg/kubefedctl/enable"
+)
+
+func TestRender(t *testing.T) {
+	typeConfig := &fedv1b1.FederatedTypeConfig{
+		ObjectMeta: metav1.ObjectMeta{Name: "deployments.apps"},
+		Spec: fedv1b1.FederatedTypeConfigSpec{
+			TargetType: fedv1b1.APIResource{
+				Group:      "apps",
+				Version:    "v1",
+				Kind:       "Deployment",
+				PluralName: "deployments",
+				Scope:      apiextv1.NamespaceScoped,
+			},
+			FederatedType: fedv1b1.APIResource{
+				Group:      "types.kubefed.io",
+				Version:    "v1beta1",
+				Kind:       "FederatedDeployment",
+				PluralName: "federateddeployments",
+				Scope:      apiextv1.NamespaceScoped,
+			},
+			Propagation: fedv1b1.PropagationEnabled,
+		},
+	}
+	clusters := []*fedv1b1.KubeFedCluster{
+		{ObjectMeta: metav1.ObjectMeta{Name: "cluster1"}},
+		{ObjectMeta: metav1.ObjectMeta{Name: "cluster2"}},
+		{ObjectMeta: metav1.ObjectMeta{Name: "cluster3"}},
+	}
+	fedNamespace := decodeObject(t, `
+apiVersion: types.kubefed.io/v1beta1
+kind: FederatedNamespace
+metadata:
+  name: ns
+  namespace: ns
+spec:
+  placement:
+    clusterSelector: {}
+`)
+	objYAML := `
+apiVersion: types.kubefed.io/v1beta1
+kind: FederatedDeployment
+metadata:
+  name: foo
+  namespace: ns
+spec:
+  retainReplicas: true
+  template:
+    metadata:
+      annotations:
+        foo: bar
+    spec:
+      replicas: 1
+  placement:
+    clusters:
+    - name: cluster1
+    - name: cluster2
+  overrides:
+  - clusterName: cluster2
+    clusterOverrides:
+    - path: /spec/replicas
+      value: 2
+`
+
+	testCases := map[string]struct {
+		clusterObjects   map[string]*unstructured.Unstructured
+		expectedReplicas map[string]int64
+	}{
+		"Resources are rendered for selected clusters with overrides": {
+			expectedReplicas: map[string]int64{
+				"cluster1": 1,
+				"cluster2": 2,
+			},
+		},
+		"Fields of cluster resources are retained": {
+			clusterObjects: map[string]*unstructured.Unstructured{
+				"cluster1": decodeObject(t, `
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: foo
+  namespace: ns
+spec:
+  replicas: 5
+`),
+			},
+			expectedReplicas: map[string]int64{
+				"cluster1": 5,
+				"cluster2": 2,
+			},
+		},
+	}
+
+	for testName, tc := range testCases {
+		t.Run(testName, func(t *testing.T) {
+			result, err := Render(RenderInput{
+				Object:             decodeObject(t, objYAML),
+				TypeConfig:         typeConfig,
+				Clusters:           clusters,
+				FederatedNamespace: fedNamespace,
+				ClusterObjects:     tc.clusterObjects,
+			})
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+
+			reason := result.PlacementDecisions["cluster3"]
+			if reason != util.PlacementNotSelected {
+				t.Errorf("Expected placement reason %q for cluster3, got %q", util.PlacementNotSelected, reason)
+			}
+			if len(result.Objects) != len(tc.expectedReplicas) {
+				t.Fatalf("Expected %d objects, got %d", len(tc.expectedReplicas), len(result.Objects))
+			}
+			for clusterName, expectedReplicas := range tc.expectedReplicas {
+				obj, ok := result.Objects[clusterName]
+				if !ok {
+					t.Fatalf("Expected an object for cluster %q", clusterName)
+				}
+				if obj.GetKind() != "Deployment" || obj.GetAPIVersion() != "apps/v1" {
+					t.Errorf("Expected an apps/v1 Deployment for cluster %q, got %s %s", clusterName, obj.GetAPIVersion(), obj.GetKind())
+				}
+				if obj.GetNamespace() != "ns" || obj.GetName() != "foo" {
+					t.Errorf("Expected ns/foo for cluster %q, got %s/%s", clusterName, obj.GetNamespace(), obj.GetName())
+				}
+				if !util.HasManagedLabel(obj) {
+					t.Errorf("Expected the object for cluster %q to have the managed label", clusterName)
+				}
+				if len(obj.GetAnnotations()) != 0 {
+					t.Errorf("Expected the object for cluster %q to have no annotations, got %v", clusterName, obj.GetAnnotations())
+				}
+				replicas, _, err := unstructured.NestedInt64(obj.Object, "spec", "replicas")
+				if err != nil {
+					t.Fatalf("Unexpected error: %v", err)
+				}
+				if replicas != expectedReplicas {
+					t.Errorf("Expected %d replicas for cluster %q, got %d", expectedReplicas, clusterName, replicas)
+				}
+			}
+			expectedWarnings := []string{"AnnotationsNotSupported"}
+			var warnings []string
+			for _, warning := range result.Warnings {
+				warnings = append(warnings, strings.SplitN(warning, ":", 2)[0])
+			}
+			if !reflect.DeepEqual(warnings, expectedWarnings) {
+				t.Errorf("Expected warnings %v, got %v", expectedWarnings, result.Warnings)
+			}
+		})
+	}
+}
+
+// TestRenderMatchesApplyOverrides verifies that the rendered
+// resources match the resources that the sync controller computes
+// from the template and ApplyOverrides.
+func TestRenderMatchesApplyOverrides(t *testing.T) {
+	typeConfig := &fedv1b1.FederatedTypeConfig{
+		ObjectMeta: metav1.ObjectMeta{Name: "configmaps"},
+		Spec: fedv1b1.FederatedTypeConfigSpec{
+			TargetType: fedv1b1.APIResource{
+				Version:    "v1",
+				Kind:       "ConfigMap",
+				PluralName: "configmaps",
+				Scope:      apiextv1.NamespaceScoped,
+			},
+			FederatedType: fedv1b1.APIResource{
+				Group:      "types.kubefed.io",
+				Version:    "v1beta1",
+				Kind:       "FederatedConfigMap",
+				PluralName: "federatedconfigmaps",
+				Scope:      apiextv1.NamespaceScoped,
+			},
+			Propagation: fedv1b1.PropagationEnabled,
+		},
+	}
+	clusters := []*fedv1b1.KubeFedCluster{
+		{ObjectMeta: metav1.ObjectMeta{Name: "cluster1", Labels: map[string]string{"region": "europe"}}},
+		{ObjectMeta: metav1.ObjectMeta{Name: "cluster2", Labels: map[string]string{"region": "asia"}}},
+	}
+	fedNamespace := decodeObject(t, `
+apiVersion: types.kubefed.io/v1beta1
+kind: FederatedNamespace
+metadata:
+  name: ns
+  namespace: ns
+spec:
+  placement:
+    clusterSelector: {}
+`)
+
+	testCases := map[string]struct {
+		overrides    string
+		expectedData map[string]map[string]interface{}
+	}{
+		"No overrides": {
+			expectedData: map[string]map[string]interface{}{
+				"cluster1": {"key": "value"},
+				"cluster2": {"key": "value"},
+			},
+		},
+		"Overrides by cluster name": {
+			overrides: `
+  - clusterName: cluster2
+    clusterOverrides:
+    - path: /data/key
+      value: overridden
+`,
+			expectedData: map[string]map[string]interface{}{
+				"cluster1": {"key": "value"},
+				"cluster2": {"key": "overridden"},
+			},
+		},
+		"Overrides by cluster selector": {
+			overrides: `
+  - clusterSelector:
+      matchLabels:
+        region: europe
+    clusterOverrides:
+    - path: /data/added
+      op: add
+      value: europe
+`,
+			expectedData: map[string]map[string]interface{}{
+				"cluster1": {"key": "value", "added": "europe"},
+				"cluster2": {"key": "value"},
+			},
+		},
+		"Overrides by name take precedence over overrides by selector": {
+			overrides: `
+  - clusterSelector: {}
+    clusterOverrides:
+    - path: /data/key
+      value: selected
+  - clusterName: cluster1
+    clusterOverrides:
+    - path: /data/key
+      value: named
+`,
+			expectedData: map[string]map[string]interface{}{
+				"cluster1": {"key": "named"},
+				"cluster2": {"key": "selected"},
+			},
+		},
+		"Templated override values": {
+			overrides: `
+  - clusterSelector: {}
+    clusterOverrides:
+    - path: /data/key
+      value: "{{ .Cluster.Name }}-{{ .Cluster.Labels.region }}"
+`,
+			expectedData: map[string]map[string]interface{}{
+				"cluster1": {"key": "cluster1-europe"},
+				"cluster2": {"key": "cluster2-asia"},
+			},
+		},
+	}
+
+	for testName, tc := range testCases {
+		t.Run(testName, func(t *testing.T) {
+			objYAML := `
+apiVersion: types.kubefed.io/v1beta1
+kind: FederatedConfigMap
+metadata:
+  name: foo
+  namespace: ns
+spec:
+  template:
+    data:
+      key: value
+  placement:
+    clusterSelector: {}
+`
+			if tc.overrides != "" {
+				objYAML += "  overrides:" + tc.overrides
+			}
+
+			result, err := Render(RenderInput{
+				Object:             decodeObject(t, objYAML),
+				TypeConfig:         typeConfig,
+				Clusters:           clusters,
+				FederatedNamespace: fedNamespace,
+			})
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+
+			// Compute the resources as the sync controller does.
+			fedResource := &federatedResource{
+				typeConfig:        typeConfig,
+				interpreter:       interpreter.ForGroupKind(schema.GroupKind{Kind: "ConfigMap"}),
+				targetName:        util.QualifiedName{Namespace: "ns", Name: "foo"},
+				federatedKind:     "FederatedConfigMap",
+				federatedName:     util.QualifiedName{Namespace: "ns", Name: "foo"},
+				federatedResource: decodeObject(t, objYAML),
+				fedNamespace:      fedNamespace,
+				eventRecorder:     &warningRecorder{},
+			}
+			selectedClusters, _, err := fedResource.ComputePlacementDecisions(clusters)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if len(result.Objects) != selectedClusters.Len() {
+				t.Fatalf("Expected %d objects, got %d", selectedClusters.Len(), len(result.Objects))
+			}
+			for _, clusterName := range selectedClusters.List() {
+				expectedObj, err := fedResource.ObjectForCluster(clusterName)
+				if err != nil {
+					t.Fatalf("Unexpected error: %v", err)
+				}
+				if err := fedResource.ApplyOverrides(expectedObj, clusterName); err != nil {
+					t.Fatalf("Unexpected error: %v", err)
+				}
+				obj := result.Objects[clusterName]
+				if !reflect.DeepEqual(obj, expectedObj) {
+					t.Fatalf("Expected the object for cluster %q to be %v, got %v", clusterName, expectedObj, obj)
+				}
+				data, _, err := unstructured.NestedMap(obj.Object, "data")
+				if err != nil {
+					t.Fatalf("Unexpected error: %v", err)
+				}
+				if !reflect.DeepEqual(data, tc.expectedData[clusterName]) {
+					t.Fatalf("Expected data %v for cluster %q, got %v", tc.expectedData[clusterName], clusterName, data)
+				}
+			}
+		})
+	}
+}
+
+func decodeObject(t *testing.T, yaml string) *unstructured.Unstructured {
+	obj := &unstructured.Unstructured{}
+	err := kfenable.DecodeYAML(strings.NewReader(yaml), obj)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	return obj
+}
@@ -35,9 +35,11 @@ import (
 
 	"sigs.k8s.io/kubefed/pkg/apis/core/typeconfig"
 	fedv1b1 "sigs.k8s.io/kubefed/pkg/apis/core/v1beta1"
+	policyv1a1 "sigs.k8s.io/kubefed/pkg/apis/policy/v1alpha1"
 	"sigs.k8s.io/kubefed/pkg/controller/sync/dispatch"
 	"sigs.k8s.io/kubefed/pkg/controller/sync/version"
 	"sigs.k8s.io/kubefed/pkg/controller/util"
+	"sigs.k8s.io/kubefed/pkg/interpreter"
 )
 
 // FederatedResource encapsulates the behavior of a logical federated
@@ -49,8 +51,12 @@ type FederatedResource interface {
 	FederatedName() util.QualifiedName
 	FederatedKind() string
 	UpdateVersions(selectedClusters []string, versionMap map[string]string) error
+	TemplateVersion() (string, error)
+	TemplateVersionsForClusters() (map[string]string, error)
 	DeleteVersions()
 	ComputePlacement(clusters []*fedv1b1.KubeFedCluster) (selectedClusters sets.String, err error)
+	ComputePlacementDecisions(clusters []*fedv1b1.KubeFedCluster) (selectedClusters sets.String, decisions util.PlacementDecisions, err error)
+	PropagationPolicy() *util.PolicyReference
 	NamespaceNotFederated() bool
 }
 
@@ -59,17 +65,35 @@ type federatedResource struct {
 
 	limitedScope      bool
 	typeConfig        typeconfig.Interface
+	interpreter       interpreter.Interpreter
 	targetIsNamespace bool
 	targetName        util.QualifiedName
 	federatedKind     string
 	federatedName     util.QualifiedName
 	federatedResource *unstructured.Unstructured
 	versionManager    *version.VersionManager
-	overridesMap      util.OverridesMap
+	overridesMap      util.PatchesMap
 	versionMap        map[string]string
 	namespace         *unstructured.Unstructured
 	fedNamespace      *unstructured.Unstructured
 	eventRecorder     record.EventRecorder
+
+	propagationPolicies        []*policyv1a1.PropagationPolicy
+	clusterPropagationPolicies []*policyv1a1.ClusterPropagationPolicy
+	// The clusters placement was last computed for.  Used to resolve
+	// overrides that target clusters by label selector.
+	clusters []*fedv1b1.KubeFedCluster
+	// The propagation policy that provided placement for the
+	// resource, as determined by the last call to
+	// ComputePlacementDecisions.
+	propagationPolicy *util.PolicyReference
+	// The overrides of the override policies that apply to the
+	// resource, in the order they are applied.
+	policyOverrides []util.PolicyOverrides
+	// Guards the version map separately from the resource since
+	// retrieving versions requires resolving the overrides for each
+	// cluster.
+	versionLock sync.Mutex
 }
 
 func (r *federatedResource) FederatedName() util.QualifiedName {
@@ -88,6 +112,14 @@ func (r *federatedResource) TargetKind() string {
 	return r.typeConfig.GetTargetType().Kind
 }
 
+func (r *federatedResource) RetainedFields() []fedv1b1.RetainedField {
+	return r.typeConfig.GetRetainedFields()
+}
+
+func (r *federatedResource) Interpreter() interpreter.Interpreter {
+	return r.interpreter
+}
+
 func (r *federatedResource) TargetGVK() schema.GroupVersionKind {
 	apiResource := r.typeConfig.GetTargetType()
 	return apiResourceToGVK(&apiResource)
@@ -103,14 +135,30 @@ func (r *federatedResource) TemplateVersion() (string, error) {
 }
 
 func (r *federatedResource) OverrideVersion() (string, error) {
-	// TODO(marun) Consider hashing overrides per cluster to minimize
-	// unnecessary updates.
-	return GetOverrideHash(r.federatedResource)
+	return GetOverrideHash(r.federatedResource, r.policyOverrides...)
+}
+
+// ClusterOverrideVersion returns a hash of the overrides for the named
+// cluster as rendered with the properties of the cluster. Unlike the
+// override version, it changes when a change to the cluster (e.g. of
+// its labels) changes the overrides that apply to the cluster or
+// their rendered values.
+func (r *federatedResource) ClusterOverrideVersion(clusterName string) (string, error) {
+	patches, err := r.renderedOverridesForCluster(clusterName)
+	if err != nil {
+		return "", err
+	}
+	obj := &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			"patches": patches,
+		},
+	}
+	return hashUnstructured(obj, "cluster overrides")
 }
 
 func (r *federatedResource) VersionForCluster(clusterName string) (string, error) {
-	r.Lock()
-	defer r.Unlock()
+	r.versionLock.Lock()
+	defer r.versionLock.Unlock()
 	if r.versionMap == nil {
 		var err error
 		r.versionMap, err = r.versionManager.Get(r)
@@ -125,15 +173,51 @@ func (r *federatedResource) UpdateVersions(selectedClusters []string, versionMap
 	return r.versionManager.Update(r, selectedClusters, versionMap)
 }
 
+func (r *federatedResource) TemplateVersionsForClusters() (map[string]string, error) {
+	return r.versionManager.GetTemplateVersions(r)
+}
+
 func (r *federatedResource) DeleteVersions() {
 	r.versionManager.Delete(r.federatedName)
 }
 
 func (r *federatedResource) ComputePlacement(clusters []*fedv1b1.KubeFedCluster) (sets.String, error) {
+	selectedClusters, _, err := r.ComputePlacementDecisions(clusters)
+	return selectedClusters, err
+}
+
+// ComputePlacementDecisions determines the selected clusters for the
+// resource along with the reason for the placement decision made for
+// each cluster. Placement not specified by the resource or its
+// federated namespace is defaulted from the propagation policy that
+// applies to it.
+func (r *federatedResource) ComputePlacementDecisions(clusters []*fedv1b1.KubeFedCluster) (sets.String, util.PlacementDecisions, error) {
+	resource, policy, err := util.ApplyPropagationPolicy(r.federatedResource, r.propagationPolicies, r.clusterPropagationPolicies)
+	if err != nil {
+		return nil, nil, err
+	}
+	r.Lock()
+	r.clusters = clusters
+	r.overridesMap = nil
+	r.propagationPolicy = policy
+	r.Unlock()
 	if r.typeConfig.GetNamespaced() {
-		return util.ComputeNamespacedPlacement(r.federatedResource, r.fedNamespace, clusters, r.limitedScope, false)
+		fedNamespace, _, err := util.ApplyPropagationPolicy(r.fedNamespace, r.propagationPolicies, r.clusterPropagationPolicies)
+		if err != nil {
+			return nil, nil, err
+		}
+		return util.ComputeNamespacedPlacementDecisions(resource, fedNamespace, clusters, r.limitedScope, false)
 	}
-	return util.ComputePlacement(r.federatedResource, clusters, false)
+	return util.ComputePlacementDecisions(resource, clusters, false)
+}
+
+// PropagationPolicy returns a reference to the propagation policy
+// that provided placement for the resource, or nil if placement was
+// not defaulted from a policy.
+func (r *federatedResource) PropagationPolicy() *util.PolicyReference {
+	r.RLock()
+	defer r.RUnlock()
+	return r.propagationPolicy
 }
 
 func (r *federatedResource) NamespaceNotFederated() bool {
@@ -210,15 +294,16 @@ func (r *federatedResource) ObjectForCluster(clusterName string) (*unstructured.
 }
 
 // ApplyOverrides applies overrides for the named cluster to the given
-// object. The managed label is added afterwards to ensure labeling even if an
-// override was attempted.
+// object. Templated override values are rendered with the properties
+// of the cluster. The managed label is added afterwards to ensure
+// labeling even if an override was attempted.
 func (r *federatedResource) ApplyOverrides(obj *unstructured.Unstructured, clusterName string) error {
-	overrides, err := r.overridesForCluster(clusterName)
+	patches, err := r.renderedOverridesForCluster(clusterName)
 	if err != nil {
 		return err
 	}
-	if overrides != nil {
-		if err := util.ApplyJSONPatch(obj, overrides); err != nil {
+	if patches != nil {
+		if err := util.ApplyPatches(obj, patches); err != nil {
 			return err
 		}
 	}
@@ -240,17 +325,46 @@ func (r *federatedResource) RecordEvent(reason, messageFmt string, args ...inter
 	r.eventRecorder.Eventf(r.Object(), corev1.EventTypeNormal, reason, messageFmt, args...)
 }
 
-func (r *federatedResource) overridesForCluster(clusterName string) (util.ClusterOverrides, error) {
+// overridesForCluster returns the patches for the named cluster and
+// the cluster, if it was among the clusters placement was computed
+// for.
+func (r *federatedResource) overridesForCluster(clusterName string) (util.ClusterPatches, *fedv1b1.KubeFedCluster, error) {
 	r.Lock()
 	defer r.Unlock()
 	if r.overridesMap == nil {
-		overridesMap, err := util.GetOverrides(r.federatedResource)
+		overridesMap, err := util.ResolveOverrides(r.federatedResource, r.clusters, r.policyOverrides...)
 		if err != nil {
-			return nil, errors.Wrapf(err, "Error reading cluster overrides")
+			return nil, nil, errors.Wrapf(err, "Error reading cluster overrides")
 		}
 		r.overridesMap = overridesMap
 	}
-	return r.overridesMap[clusterName], nil
+	var cluster *fedv1b1.KubeFedCluster
+	for _, c := range r.clusters {
+		if c.Name == clusterName {
+			cluster = c
+			break
+		}
+	}
+	return r.overridesMap[clusterName], cluster, nil
+}
+
+// renderedOverridesForCluster returns the patches for the named
+// cluster with templated values rendered with the properties of the
+// cluster.
+func (r *federatedResource) renderedOverridesForCluster(clusterName string) (util.ClusterPatches, error) {
+	patches, cluster, err := r.overridesForCluster(clusterName)
+	if err != nil {
+		return nil, err
+	}
+	if patches == nil {
+		return nil, nil
+	}
+	data := util.NewOverrideTemplateData(clusterName, cluster)
+	patches, err = util.RenderPatches(patches, data)
+	if err != nil {
+		return nil, errors.Wrapf(err, "Error rendering overrides for cluster %q", clusterName)
+	}
+	return patches, nil
 }
 
 func GetTemplateHash(fieldMap map[string]interface{}) (string, error) {
@@ -267,20 +381,28 @@ func GetTemplateHash(fieldMap map[string]interface{}) (string, error) {
 	return hashUnstructured(obj, description)
 }
 
-func GetOverrideHash(rawObj *unstructured.Unstructured) (string, error) {
+// GetOverrideHash returns a hash of the overrides of the given
+// federated resource and of the override policies that apply to it.
+func GetOverrideHash(rawObj *unstructured.Unstructured, policies ...util.PolicyOverrides) (string, error) {
 	override := util.GenericOverride{}
 	err := util.UnstructuredToInterface(rawObj, &override)
 	if err != nil {
 		return "", errors.Wrap(err, "Error retrieving overrides")
 	}
-	if override.Spec == nil {
+	if override.Spec == nil && len(policies) == 0 {
 		return "", nil
 	}
 	// Only hash the overrides
 	obj := &unstructured.Unstructured{
-		Object: map[string]interface{}{
-			"overrides": override.Spec.Overrides,
-		},
+		Object: map[string]interface{}{},
+	}
+	if override.Spec != nil {
+		obj.Object["overrides"] = override.Spec.Overrides
+	}
+	// Policy overrides are only included when present so that the
+	// hash of a resource without policies is unchanged.
+	if len(policies) > 0 {
+		obj.Object["policyOverrides"] = policies
 	}
 
 	return hashUnstructured(obj, "overrides")
@@ -17,11 +17,18 @@ limitations under the License.
 package sync
 
 import (
+	"context"
 	"strings"
 	"testing"
 
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	runtimeclient "sigs.k8s.io/controller-runtime/pkg/client"
 
+	fedv1b1 "sigs.k8s.io/kubefed/pkg/apis/core/v1beta1"
+	genericclient "sigs.k8s.io/kubefed/pkg/client/generic"
+	"sigs.k8s.io/kubefed/pkg/controller/sync/version"
+	"sigs.k8s.io/kubefed/pkg/controller/util"
 	kfenable "sigs.k8s.io/kubefed/pkg/kubefedctl/enable"
 )
 
@@ -47,3 +54,180 @@ spec:
 		t.Fatalf("Expected %s, got %s", expectedHash, hash)
 	}
 }
+
+func TestGetOverrideHash(t *testing.T) {
+	obj := &unstructured.Unstructured{}
+	yaml := `
+kind: foo
+spec:
+  overrides:
+  - clusterName: cluster1
+    clusterOverrides:
+    - path: /spec/replicas
+      value: 1
+`
+	err := kfenable.DecodeYAML(strings.NewReader(yaml), obj)
+	if err != nil {
+		t.Fatalf("An unexpected error occurred: %v", err)
+	}
+	hash, err := GetOverrideHash(obj)
+	if err != nil {
+		t.Fatalf("An unexpected error occurred: %v", err)
+	}
+	policies := []util.PolicyOverrides{
+		{
+			Policy: util.PolicyReference{Kind: util.OverridePolicyKind, Namespace: "ns", Name: "policy"},
+			Overrides: []util.GenericOverrideItem{
+				{
+					ClusterName:      "cluster1",
+					ClusterOverrides: []util.ClusterOverride{{Path: "/spec/paused", Value: true}},
+				},
+			},
+		},
+	}
+	policyHash, err := GetOverrideHash(obj, policies...)
+	if err != nil {
+		t.Fatalf("An unexpected error occurred: %v", err)
+	}
+	if hash == policyHash {
+		t.Fatalf("Expected the hash to change when override policies apply")
+	}
+	policies[0].Overrides[0].ClusterOverrides[0].Value = false
+	changedPolicyHash, err := GetOverrideHash(obj, policies...)
+	if err != nil {
+		t.Fatalf("An unexpected error occurred: %v", err)
+	}
+	if policyHash == changedPolicyHash {
+		t.Fatalf("Expected the hash to change when policy overrides change")
+	}
+}
+
+func TestClusterChangeInvalidatesVersions(t *testing.T) {
+	fedObj := decodeObject(t, `
+apiVersion: types.kubefed.io/v1beta1
+kind: FederatedConfigMap
+metadata:
+  name: foo
+  namespace: ns
+  uid: uid
+spec:
+  template:
+    data:
+      foo: bar
+  overrides:
+  - clusterName: cluster1
+    clusterOverrides:
+    - op: add
+      path: /data/tier
+      value: "{{ .Cluster.Labels.tier }}"
+  - clusterSelector:
+      matchLabels:
+        tier: gold
+    clusterOverrides:
+    - op: add
+      path: /data/gold
+      value: "true"
+`)
+	newCluster := func(name string, labels map[string]string) *fedv1b1.KubeFedCluster {
+		return &fedv1b1.KubeFedCluster{ObjectMeta: metav1.ObjectMeta{Name: name, Labels: labels}}
+	}
+	clusters := []*fedv1b1.KubeFedCluster{
+		newCluster("cluster1", map[string]string{"tier": "silver"}),
+		newCluster("cluster2", map[string]string{"tier": "silver"}),
+	}
+	versionMap := map[string]string{
+		"cluster1": "rv:1",
+		"cluster2": "rv:1",
+	}
+
+	testCases := map[string]struct {
+		changedClusters  []*fedv1b1.KubeFedCluster
+		expectedVersions map[string]string
+	}{
+		"Unrelated label change retains versions": {
+			changedClusters: []*fedv1b1.KubeFedCluster{
+				newCluster("cluster1", map[string]string{"tier": "silver", "foo": "bar"}),
+				newCluster("cluster2", map[string]string{"tier": "silver"}),
+			},
+			expectedVersions: versionMap,
+		},
+		"Label change altering a rendered value invalidates the version of the cluster": {
+			changedClusters: []*fedv1b1.KubeFedCluster{
+				newCluster("cluster1", map[string]string{"tier": "bronze"}),
+				newCluster("cluster2", map[string]string{"tier": "silver"}),
+			},
+			expectedVersions: map[string]string{
+				"cluster2": "rv:1",
+			},
+		},
+		"Label change altering selected overrides invalidates the version of the cluster": {
+			changedClusters: []*fedv1b1.KubeFedCluster{
+				newCluster("cluster1", map[string]string{"tier": "silver"}),
+				newCluster("cluster2", map[string]string{"tier": "gold"}),
+			},
+			expectedVersions: map[string]string{
+				"cluster1": "rv:1",
+			},
+		},
+	}
+	for testName, tc := range testCases {
+		t.Run(testName, func(t *testing.T) {
+			versionManager := version.NewVersionManager(&fakeVersionClient{}, true, "FederatedConfigMap", "ConfigMap", "")
+			newResource := func(clusters []*fedv1b1.KubeFedCluster) *federatedResource {
+				return &federatedResource{
+					federatedName:     util.NewQualifiedName(fedObj),
+					federatedResource: fedObj,
+					versionManager:    versionManager,
+					clusters:          clusters,
+				}
+			}
+
+			resource := newResource(clusters)
+			if err := resource.UpdateVersions([]string{"cluster1", "cluster2"}, versionMap); err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+
+			resource = newResource(tc.changedClusters)
+			for _, cluster := range tc.changedClusters {
+				clusterName := cluster.Name
+				recordedVersion, err := resource.VersionForCluster(clusterName)
+				if err != nil {
+					t.Fatalf("Unexpected error: %v", err)
+				}
+				if recordedVersion != tc.expectedVersions[clusterName] {
+					t.Fatalf("Expected version %q for cluster %q, got %q", tc.expectedVersions[clusterName], clusterName, recordedVersion)
+				}
+
+				desiredObj := &unstructured.Unstructured{Object: map[string]interface{}{
+					"apiVersion": "v1",
+					"kind":       "ConfigMap",
+					"data":       map[string]interface{}{"foo": "bar"},
+				}}
+				if err := resource.ApplyOverrides(desiredObj, clusterName); err != nil {
+					t.Fatalf("Unexpected error: %v", err)
+				}
+				clusterObj := &unstructured.Unstructured{Object: map[string]interface{}{}}
+				clusterObj.SetResourceVersion("1")
+				expectedUpdate := tc.expectedVersions[clusterName] == ""
+				if util.ObjectNeedsUpdate(desiredObj, clusterObj, recordedVersion, false) != expectedUpdate {
+					t.Fatalf("Expected update of cluster %q to be needed: %v", clusterName, expectedUpdate)
+				}
+			}
+		})
+	}
+}
+
+// fakeVersionClient records propagated versions without a backing
+// API.
+type fakeVersionClient struct {
+	genericclient.Client
+}
+
+func (c *fakeVersionClient) Create(ctx context.Context, obj runtimeclient.Object) error {
+	obj.SetResourceVersion("1")
+	return nil
+}
+
+func (c *fakeVersionClient) UpdateStatus(ctx context.Context, obj runtimeclient.Object) error {
+	return nil
+}
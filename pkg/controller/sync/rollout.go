@@ -0,0 +1,175 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sync
+
+import (
+	"fmt"
+
+	"github.com/pkg/errors"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	"sigs.k8s.io/kubefed/pkg/controller/sync/status"
+	"sigs.k8s.io/kubefed/pkg/controller/util"
+	"sigs.k8s.io/kubefed/pkg/interpreter"
+)
+
+// computeRollout determines the selected clusters that changes to the
+// given federated resource may not yet be propagated to according to
+// its rollout strategy, along with the progress of the rollout. No
+// clusters are pending and the rollout status is nil if the resource
+// does not have a rollout strategy.
+func (s *KubeFedSyncController) computeRollout(fedResource FederatedResource, selectedClusterNames sets.String) (sets.String, *status.GenericRolloutStatus, error) {
+	strategy, err := util.GetRolloutStrategy(fedResource.Object())
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "Failed to determine rollout strategy")
+	}
+	if strategy == nil {
+		return sets.NewString(), nil, nil
+	}
+
+	templateVersion, err := fedResource.TemplateVersion()
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "Failed to determine template version")
+	}
+	templateVersions, err := fedResource.TemplateVersionsForClusters()
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "Failed to retrieve template versions of clusters")
+	}
+	previous, err := status.GetRolloutStatus(fedResource.Object())
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// Only the health of resources that the template version has
+	// been propagated to determines the progress of the rollout.
+	key := fedResource.TargetName().String()
+	clusterObjs := make(map[string]*unstructured.Unstructured)
+	for _, clusterName := range selectedClusterNames.List() {
+		if templateVersions[clusterName] != templateVersion {
+			continue
+		}
+		rawClusterObj, _, err := s.informer.GetTargetStore().GetByKey(clusterName, key)
+		if err != nil || rawClusterObj == nil {
+			continue
+		}
+		clusterObjs[clusterName] = rawClusterObj.(*unstructured.Unstructured)
+	}
+	// The health of the resources is determined by the interpreter
+	// of the target type, as for the Ready condition.
+	health := make(map[string]util.RolloutHealth)
+	clusterHealth, err := interpreter.HealthForClusters(fedResource.Interpreter(), clusterObjs)
+	if err != nil {
+		runtime.HandleError(errors.Wrapf(err, "failed to determine rollout health of %s %q", fedResource.TargetKind(), key))
+	}
+	for clusterName, value := range clusterHealth {
+		health[clusterName] = util.RolloutHealthFor(value)
+	}
+
+	pending, rollout := planRollout(strategy, previous, templateVersion, selectedClusterNames, templateVersions, health)
+	return pending, rollout, nil
+}
+
+// planRollout determines the selected clusters whose resources may
+// not yet be created or updated, and the progress of the rollout of
+// the given template version. A batch of clusters is rolled out once
+// the resources in all clusters of the previous batches have the
+// template version and are healthy. A rollout halts if a resource
+// updated by the rollout fails, and resumes once the resources in all
+// clusters of the halted batch are healthy, e.g. after the failure
+// was fixed in the cluster, or when the template version changes.
+// Clusters without a template version have no resource propagated to
+// them yet and are never held back, since creating a resource does
+// not risk disrupting an existing workload.
+func planRollout(strategy *util.GenericRolloutStrategy, previous *status.GenericRolloutStatus, templateVersion string,
+	selectedClusters sets.String, templateVersions map[string]string, health map[string]util.RolloutHealth) (sets.String, *status.GenericRolloutStatus) {
+	batches := strategy.Batches(selectedClusters)
+
+	rollout := &status.GenericRolloutStatus{
+		TemplateVersion: templateVersion,
+		Phase:           status.RolloutComplete,
+		CurrentBatch:    int32(len(batches)),
+		TotalBatches:    int32(len(batches)),
+	}
+	for _, clusterName := range selectedClusters.UnsortedList() {
+		if templateVersions[clusterName] == templateVersion {
+			rollout.UpdatedClusters++
+		}
+	}
+
+	halted := previous != nil && previous.TemplateVersion == templateVersion && previous.Phase == status.RolloutHalted
+
+	lastBatch := len(batches) - 1
+	for i, batch := range batches {
+		complete := true
+		failedCluster := ""
+		for _, clusterName := range batch {
+			if templateVersions[clusterName] != templateVersion {
+				complete = false
+				continue
+			}
+			switch health[clusterName] {
+			case util.RolloutHealthy:
+			case util.RolloutFailed:
+				failedCluster = clusterName
+			default:
+				complete = false
+			}
+		}
+		if failedCluster != "" {
+			rollout.Phase = status.RolloutHalted
+			rollout.CurrentBatch = int32(i + 1)
+			rollout.Message = fmt.Sprintf("The resource in cluster %q failed to become healthy", failedCluster)
+			lastBatch = i
+			break
+		}
+		// A halted batch is only resumed once it has fully
+		// recovered, to avoid resuming while a failed resource is
+		// still progressing.
+		if halted && int32(i+1) >= previous.CurrentBatch {
+			if !complete {
+				rollout.Phase = status.RolloutHalted
+				rollout.CurrentBatch = int32(i + 1)
+				rollout.Message = previous.Message
+				lastBatch = i
+				break
+			}
+			halted = false
+		}
+		if !complete {
+			rollout.Phase = status.RolloutProgressing
+			rollout.CurrentBatch = int32(i + 1)
+			lastBatch = i
+			break
+		}
+	}
+
+	// Clusters that already have the template version are not held
+	// back, to allow their resources to continue to be reconciled.
+	pending := sets.NewString()
+	for _, batch := range batches[lastBatch+1:] {
+		for _, clusterName := range batch {
+			version := templateVersions[clusterName]
+			if version != "" && version != templateVersion {
+				pending.Insert(clusterName)
+			}
+		}
+	}
+	return pending, rollout
+}
@@ -0,0 +1,249 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sync
+
+import (
+	"reflect"
+	"testing"
+
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	"sigs.k8s.io/kubefed/pkg/controller/sync/status"
+	"sigs.k8s.io/kubefed/pkg/controller/util"
+)
+
+func TestPlanRollout(t *testing.T) {
+	const (
+		oldVersion = "old"
+		newVersion = "new"
+	)
+	batchSize := intstr.FromInt(2)
+	strategy := &util.GenericRolloutStrategy{
+		CanaryClusters: []util.GenericClusterReference{{Name: "canary"}},
+		BatchSize:      &batchSize,
+	}
+	// Batches: [canary], [c1, c2], [c3]
+	selectedClusters := sets.NewString("canary", "c1", "c2", "c3")
+
+	testCases := map[string]struct {
+		previous         *status.GenericRolloutStatus
+		templateVersions map[string]string
+		health           map[string]util.RolloutHealth
+		expectedPending  []string
+		expectedStatus   status.GenericRolloutStatus
+	}{
+		"Only canaries are updated first": {
+			templateVersions: map[string]string{
+				"canary": oldVersion,
+				"c1":     oldVersion,
+				"c2":     oldVersion,
+				"c3":     oldVersion,
+			},
+			expectedPending: []string{"c1", "c2", "c3"},
+			expectedStatus: status.GenericRolloutStatus{
+				Phase:        status.RolloutProgressing,
+				CurrentBatch: 1,
+			},
+		},
+		"Next batch waits for updated canaries to become healthy": {
+			templateVersions: map[string]string{
+				"canary": newVersion,
+				"c1":     oldVersion,
+				"c2":     oldVersion,
+				"c3":     oldVersion,
+			},
+			health: map[string]util.RolloutHealth{
+				"canary": util.RolloutProgressing,
+			},
+			expectedPending: []string{"c1", "c2", "c3"},
+			expectedStatus: status.GenericRolloutStatus{
+				Phase:           status.RolloutProgressing,
+				CurrentBatch:    1,
+				UpdatedClusters: 1,
+			},
+		},
+		"Next batch is rolled out once canaries are healthy": {
+			templateVersions: map[string]string{
+				"canary": newVersion,
+				"c1":     oldVersion,
+				"c2":     oldVersion,
+				"c3":     oldVersion,
+			},
+			health: map[string]util.RolloutHealth{
+				"canary": util.RolloutHealthy,
+			},
+			expectedPending: []string{"c3"},
+			expectedStatus: status.GenericRolloutStatus{
+				Phase:           status.RolloutProgressing,
+				CurrentBatch:    2,
+				UpdatedClusters: 1,
+			},
+		},
+		"Failure of an updated cluster halts the rollout": {
+			templateVersions: map[string]string{
+				"canary": newVersion,
+				"c1":     newVersion,
+				"c2":     newVersion,
+				"c3":     oldVersion,
+			},
+			health: map[string]util.RolloutHealth{
+				"canary": util.RolloutHealthy,
+				"c1":     util.RolloutFailed,
+				"c2":     util.RolloutHealthy,
+			},
+			expectedPending: []string{"c3"},
+			expectedStatus: status.GenericRolloutStatus{
+				Phase:           status.RolloutHalted,
+				CurrentBatch:    2,
+				UpdatedClusters: 3,
+				Message:         `The resource in cluster "c1" failed to become healthy`,
+			},
+		},
+		"Halted rollout remains halted until the halted batch recovers": {
+			previous: &status.GenericRolloutStatus{
+				TemplateVersion: newVersion,
+				Phase:           status.RolloutHalted,
+				CurrentBatch:    2,
+				Message:         "halted",
+			},
+			templateVersions: map[string]string{
+				"canary": newVersion,
+				"c1":     newVersion,
+				"c2":     newVersion,
+				"c3":     oldVersion,
+			},
+			health: map[string]util.RolloutHealth{
+				"canary": util.RolloutHealthy,
+				"c1":     util.RolloutProgressing,
+				"c2":     util.RolloutHealthy,
+			},
+			expectedPending: []string{"c3"},
+			expectedStatus: status.GenericRolloutStatus{
+				Phase:           status.RolloutHalted,
+				CurrentBatch:    2,
+				UpdatedClusters: 3,
+				Message:         "halted",
+			},
+		},
+		"Halted rollout resumes once the halted batch recovers": {
+			previous: &status.GenericRolloutStatus{
+				TemplateVersion: newVersion,
+				Phase:           status.RolloutHalted,
+				CurrentBatch:    2,
+				Message:         "halted",
+			},
+			templateVersions: map[string]string{
+				"canary": newVersion,
+				"c1":     newVersion,
+				"c2":     newVersion,
+				"c3":     oldVersion,
+			},
+			health: map[string]util.RolloutHealth{
+				"canary": util.RolloutHealthy,
+				"c1":     util.RolloutHealthy,
+				"c2":     util.RolloutHealthy,
+			},
+			expectedPending: []string{},
+			expectedStatus: status.GenericRolloutStatus{
+				Phase:           status.RolloutProgressing,
+				CurrentBatch:    3,
+				UpdatedClusters: 3,
+			},
+		},
+		"Halted rollout of a previous template version does not halt": {
+			previous: &status.GenericRolloutStatus{
+				TemplateVersion: oldVersion,
+				Phase:           status.RolloutHalted,
+				CurrentBatch:    1,
+			},
+			templateVersions: map[string]string{
+				"canary": oldVersion,
+				"c1":     oldVersion,
+				"c2":     oldVersion,
+				"c3":     oldVersion,
+			},
+			expectedPending: []string{"c1", "c2", "c3"},
+			expectedStatus: status.GenericRolloutStatus{
+				Phase:        status.RolloutProgressing,
+				CurrentBatch: 1,
+			},
+		},
+		"Clusters that already have the template version are not pending": {
+			templateVersions: map[string]string{
+				"canary": oldVersion,
+				"c1":     oldVersion,
+				"c2":     oldVersion,
+				"c3":     newVersion,
+			},
+			health: map[string]util.RolloutHealth{
+				"c3": util.RolloutHealthy,
+			},
+			expectedPending: []string{"c1", "c2"},
+			expectedStatus: status.GenericRolloutStatus{
+				Phase:           status.RolloutProgressing,
+				CurrentBatch:    1,
+				UpdatedClusters: 1,
+			},
+		},
+		"Clusters without a propagated resource are not pending": {
+			templateVersions: map[string]string{
+				"canary": oldVersion,
+				"c3":     oldVersion,
+			},
+			expectedPending: []string{"c3"},
+			expectedStatus: status.GenericRolloutStatus{
+				Phase:        status.RolloutProgressing,
+				CurrentBatch: 1,
+			},
+		},
+		"Rollout completes once all batches are healthy": {
+			templateVersions: map[string]string{
+				"canary": newVersion,
+				"c1":     newVersion,
+				"c2":     newVersion,
+				"c3":     newVersion,
+			},
+			health: map[string]util.RolloutHealth{
+				"canary": util.RolloutHealthy,
+				"c1":     util.RolloutHealthy,
+				"c2":     util.RolloutHealthy,
+				"c3":     util.RolloutHealthy,
+			},
+			expectedPending: []string{},
+			expectedStatus: status.GenericRolloutStatus{
+				Phase:           status.RolloutComplete,
+				CurrentBatch:    3,
+				UpdatedClusters: 4,
+			},
+		},
+	}
+	for testName, tc := range testCases {
+		t.Run(testName, func(t *testing.T) {
+			pending, rollout := planRollout(strategy, tc.previous, newVersion, selectedClusters, tc.templateVersions, tc.health)
+			if !reflect.DeepEqual(pending.List(), tc.expectedPending) {
+				t.Fatalf("Expected pending clusters %v, got %v", tc.expectedPending, pending.List())
+			}
+			expectedStatus := tc.expectedStatus
+			expectedStatus.TemplateVersion = newVersion
+			expectedStatus.TotalBatches = 3
+			if !reflect.DeepEqual(*rollout, expectedStatus) {
+				t.Fatalf("Expected rollout status %#v, got %#v", expectedStatus, *rollout)
+			}
+		})
+	}
+}
@@ -19,6 +19,7 @@ package status
 import (
 	"encoding/json"
 	"reflect"
+	"sort"
 	"time"
 
 	"github.com/pkg/errors"
@@ -56,6 +57,37 @@ const (
 	VersionRetrievalFailed PropagationStatus = "VersionRetrievalFailed"
 	ClientRetrievalFailed  PropagationStatus = "ClientRetrievalFailed"
 	ManagedLabelFalse      PropagationStatus = "ManagedLabelFalse"
+	ApplyConflict          PropagationStatus = "ApplyConflict"
+
+	// An error prevented determining the dependencies or dependents
+	// of the federated resource.
+	DependencyRetrievalFailed PropagationStatus = "DependencyRetrievalFailed"
+
+	// Differences from the desired state that were reported but not
+	// updated due to the reconcile mode being ReportOnly.
+	Drifted PropagationStatus = "Drifted"
+
+	// An operation that was not performed due to propagation of the
+	// federated resource being paused.
+	PropagationPaused PropagationStatus = "PropagationPaused"
+
+	// Creation or deletion that waits for the propagation of the
+	// federated resources that the federated resource depends on, or
+	// for the removal of the federated resources depending on it.
+	WaitingForDependencies PropagationStatus = "WaitingForDependencies"
+	WaitingForDependents   PropagationStatus = "WaitingForDependents"
+
+	// Creation that cannot proceed because the federated resource
+	// depends on itself through its dependencies.
+	DependencyCycle PropagationStatus = "DependencyCycle"
+
+	// No operation was dispatched because the cluster throttled
+	// requests and is being backed off.
+	ClusterThrottled PropagationStatus = "ClusterThrottled"
+
+	// A change that has not yet been propagated because the cluster
+	// is part of a later batch of a staged rollout.
+	RolloutPending PropagationStatus = "RolloutPending"
 
 	// Operation timeout errors
 	CreationTimedOut     PropagationStatus = "CreationTimedOut"
@@ -66,16 +98,28 @@ const (
 	AggregateSuccess       AggregateReason = ""
 	ClusterRetrievalFailed AggregateReason = "ClusterRetrievalFailed"
 	ComputePlacementFailed AggregateReason = "ComputePlacementFailed"
+	ComputeRolloutFailed   AggregateReason = "ComputeRolloutFailed"
 	CheckClusters          AggregateReason = "CheckClusters"
 	NamespaceNotFederated  AggregateReason = "NamespaceNotFederated"
 
+	// Reasons for the Ready condition being false.
+	ClustersProgressing AggregateReason = "ClustersProgressing"
+	ClustersDegraded    AggregateReason = "ClustersDegraded"
+
+	// Reason for the Ready condition being unknown.
+	HealthCheckFailed AggregateReason = "HealthCheckFailed"
+
 	PropagationConditionType ConditionType = "Propagation"
+	PausedConditionType      ConditionType = "Paused"
+	ReadyConditionType       ConditionType = "Ready"
 )
 
 type GenericClusterStatus struct {
-	Name         string            `json:"name"`
-	Status       PropagationStatus `json:"status,omitempty"`
-	RemoteStatus interface{}       `json:"remoteStatus,omitempty"`
+	Name         string              `json:"name"`
+	Status       PropagationStatus   `json:"status,omitempty"`
+	RemoteStatus interface{}         `json:"remoteStatus,omitempty"`
+	DriftedPaths []string            `json:"driftedPaths,omitempty"`
+	Health       util.ResourceHealth `json:"health,omitempty"`
 }
 
 type GenericCondition struct {
@@ -93,12 +137,51 @@ type GenericCondition struct {
 	// (brief) reason for the condition's last transition.
 	// +optional
 	Reason AggregateReason `json:"reason,omitempty"`
+	// Human readable details of the condition's last transition.
+	// +optional
+	Message string `json:"message,omitempty"`
+}
+
+// GenericClusterPlacement explains the placement decision made for
+// a cluster.
+type GenericClusterPlacement struct {
+	Name   string               `json:"name"`
+	Reason util.PlacementReason `json:"reason"`
+}
+
+// RolloutPhase is the phase of a staged rollout.
+type RolloutPhase string
+
+const (
+	RolloutProgressing RolloutPhase = "Progressing"
+	RolloutHalted      RolloutPhase = "Halted"
+	RolloutComplete    RolloutPhase = "Complete"
+)
+
+// GenericRolloutStatus tracks the progress of a staged rollout of a
+// version of the template of a federated resource.
+type GenericRolloutStatus struct {
+	// The version of the template being rolled out.
+	TemplateVersion string       `json:"templateVersion"`
+	Phase           RolloutPhase `json:"phase"`
+	// The number of the batch being rolled out, starting at 1. Equal
+	// to the total number of batches once the rollout is complete.
+	CurrentBatch int32 `json:"currentBatch"`
+	TotalBatches int32 `json:"totalBatches"`
+	// The number of selected clusters the template version has been
+	// propagated to.
+	UpdatedClusters int32 `json:"updatedClusters"`
+	// Explains why the rollout was halted.
+	Message string `json:"message,omitempty"`
 }
 
 type GenericFederatedStatus struct {
-	ObservedGeneration int64                  `json:"observedGeneration,omitempty"`
-	Conditions         []*GenericCondition    `json:"conditions,omitempty"`
-	Clusters           []GenericClusterStatus `json:"clusters,omitempty"`
+	ObservedGeneration int64                     `json:"observedGeneration,omitempty"`
+	Conditions         []*GenericCondition       `json:"conditions,omitempty"`
+	Clusters           []GenericClusterStatus    `json:"clusters,omitempty"`
+	Placement          []GenericClusterPlacement `json:"placement,omitempty"`
+	PropagationPolicy  *util.PolicyReference     `json:"propagationPolicy,omitempty"`
+	Rollout            *GenericRolloutStatus     `json:"rollout,omitempty"`
 }
 
 type GenericFederatedResource struct {
@@ -111,8 +194,24 @@ type GenericFederatedResource struct {
 type PropagationStatusMap map[string]PropagationStatus
 
 type CollectedPropagationStatus struct {
-	StatusMap        PropagationStatusMap
-	ResourcesUpdated bool
+	StatusMap          PropagationStatusMap
+	ResourcesUpdated   bool
+	PlacementDecisions util.PlacementDecisions
+	PropagationPolicy  *util.PolicyReference
+	// Paths of the fields that differ from the desired state for
+	// clusters with a Drifted status.
+	DriftMap map[string][]string
+	// Whether propagation of the federated resource is paused.
+	Paused bool
+	// The progress of a staged rollout, if the federated resource
+	// has a rollout strategy.
+	Rollout *GenericRolloutStatus
+	// The health of the resource in each selected cluster. The Ready
+	// condition is not updated if nil.
+	Health map[string]util.ResourceHealth
+	// The error that prevented determining the health of the
+	// resource, if any.
+	HealthError string
 }
 
 type CollectedResourceStatus struct {
@@ -164,6 +263,27 @@ func SetFederatedStatus(fedObject *unstructured.Unstructured, reason AggregateRe
 	return true, nil
 }
 
+// GetRolloutStatus returns the rollout status recorded for the given
+// federated resource, or nil if none has been recorded.
+func GetRolloutStatus(fedObject *unstructured.Unstructured) (*GenericRolloutStatus, error) {
+	resource := &GenericFederatedResource{}
+	err := util.UnstructuredToInterface(fedObject, resource)
+	if err != nil {
+		return nil, errors.Wrapf(err, "Failed to unmarshall to generic resource")
+	}
+	if resource.Status == nil {
+		return nil, nil
+	}
+	return resource.Status.Rollout, nil
+}
+
+// IsWaitingForDependencies returns whether the given
+// PropagationStatus indicates that an operation waits for the
+// dependencies or dependents of a federated resource.
+func IsWaitingForDependencies(status PropagationStatus) bool {
+	return status == WaitingForDependencies || status == WaitingForDependents
+}
+
 // IsRecoverableError returns whether the given PropagationStatus is a possibly recoverable error.
 func IsRecoverableError(status PropagationStatus) bool {
 	switch status {
@@ -174,6 +294,7 @@ func IsRecoverableError(status PropagationStatus) bool {
 		LabelRemovalFailed,
 		RetrievalFailed,
 		ClientRetrievalFailed,
+		DependencyRetrievalFailed,
 		CreationTimedOut,
 		UpdateTimedOut,
 		DeletionTimedOut,
@@ -206,7 +327,11 @@ func (s *GenericFederatedStatus) update(generation int64, reason AggregateReason
 		}
 	}
 
-	clustersChanged := s.setClusters(collectedStatus.StatusMap, collectedResourceStatus.StatusMap, resourceStatusCollection)
+	clustersChanged := s.setClusters(collectedStatus, collectedResourceStatus.StatusMap, resourceStatusCollection)
+
+	placementChanged := s.setPlacement(collectedStatus.PlacementDecisions)
+	policyChanged := s.setPropagationPolicy(collectedStatus.PropagationPolicy)
+	rolloutChanged := s.setRollout(collectedStatus.Rollout)
 
 	// Indicate that changes were propagated if either status.clusters
 	// was changed or if existing resources were updated (which could
@@ -215,8 +340,10 @@ func (s *GenericFederatedStatus) update(generation int64, reason AggregateReason
 	changesPropagated := clustersChanged || len(collectedStatus.StatusMap) > 0 && len(collectedResourceStatus.StatusMap) > 0 && collectedStatus.ResourcesUpdated
 
 	propStatusUpdated := s.setPropagationCondition(reason, changesPropagated)
+	pausedStatusUpdated := s.setPausedCondition(collectedStatus.Paused)
+	readyStatusUpdated := s.setReadyCondition(collectedStatus.Health, collectedStatus.HealthError)
 
-	statusUpdated := generationUpdated || propStatusUpdated
+	statusUpdated := generationUpdated || propStatusUpdated || pausedStatusUpdated || readyStatusUpdated || placementChanged || policyChanged || rolloutChanged
 
 	klog.V(4).Infof("Value of flags: propStatusUpdated: '%v'; statusUpdated '%v'; changesPropagated '%v'", propStatusUpdated, statusUpdated, changesPropagated)
 	return statusUpdated
@@ -225,17 +352,19 @@ func (s *GenericFederatedStatus) update(generation int64, reason AggregateReason
 // setClusters sets the status.clusters slice from propagation and resource status
 // maps. Returns a boolean indication of whether the status.clusters was
 // modified.
-func (s *GenericFederatedStatus) setClusters(statusMap PropagationStatusMap, resourceStatusMap map[string]interface{}, resourceStatusCollection bool) bool {
-	if !s.clustersDiffer(statusMap, resourceStatusMap, resourceStatusCollection) {
+func (s *GenericFederatedStatus) setClusters(collectedStatus CollectedPropagationStatus, resourceStatusMap map[string]interface{}, resourceStatusCollection bool) bool {
+	if !s.clustersDiffer(collectedStatus, resourceStatusMap, resourceStatusCollection) {
 		return false
 	}
 	s.Clusters = []GenericClusterStatus{}
-	for clusterName, status := range statusMap {
+	for clusterName, status := range collectedStatus.StatusMap {
 		rawResourceStatus := resourceStatusMap[clusterName]
 		s.Clusters = append(s.Clusters, GenericClusterStatus{
 			Name:         clusterName,
 			Status:       status,
 			RemoteStatus: rawResourceStatus,
+			DriftedPaths: collectedStatus.DriftMap[clusterName],
+			Health:       collectedStatus.Health[clusterName],
 		})
 	}
 	return true
@@ -243,7 +372,8 @@ func (s *GenericFederatedStatus) setClusters(statusMap PropagationStatusMap, res
 
 // clustersDiffer checks whether `status.clusters` differs from the
 // given status map.
-func (s *GenericFederatedStatus) clustersDiffer(statusMap PropagationStatusMap, resourceStatusMap map[string]interface{}, resourceStatusCollection bool) bool {
+func (s *GenericFederatedStatus) clustersDiffer(collectedStatus CollectedPropagationStatus, resourceStatusMap map[string]interface{}, resourceStatusCollection bool) bool {
+	statusMap := collectedStatus.StatusMap
 	if len(s.Clusters) != len(statusMap) || resourceStatusCollection && len(s.Clusters) != len(resourceStatusMap) {
 		klog.V(4).Infof("Clusters differs from the size: clusters = %v, statusMap = %v, resourceStatusMap = %v", s.Clusters, statusMap, resourceStatusMap)
 		return true
@@ -252,6 +382,12 @@ func (s *GenericFederatedStatus) clustersDiffer(statusMap PropagationStatusMap,
 		if statusMap[status.Name] != status.Status {
 			return true
 		}
+		if !reflect.DeepEqual(collectedStatus.DriftMap[status.Name], status.DriftedPaths) {
+			return true
+		}
+		if collectedStatus.Health[status.Name] != status.Health {
+			return true
+		}
 		if !reflect.DeepEqual(resourceStatusMap[status.Name], status.RemoteStatus) {
 			klog.V(4).Infof("Clusters resource status differ: %v VS %v", resourceStatusMap[status.Name], status.RemoteStatus)
 			return true
@@ -260,6 +396,53 @@ func (s *GenericFederatedStatus) clustersDiffer(statusMap PropagationStatusMap,
 	return false
 }
 
+// setPlacement sets the status.placement slice from the given
+// placement decisions. Returns a boolean indication of whether
+// status.placement was modified.
+func (s *GenericFederatedStatus) setPlacement(decisions util.PlacementDecisions) bool {
+	// Placement is not computed when reconciliation fails early, and
+	// the previous decisions are kept.
+	if decisions == nil {
+		return false
+	}
+	var placement []GenericClusterPlacement
+	for clusterName, reason := range decisions {
+		placement = append(placement, GenericClusterPlacement{
+			Name:   clusterName,
+			Reason: reason,
+		})
+	}
+	sort.Slice(placement, func(i, j int) bool {
+		return placement[i].Name < placement[j].Name
+	})
+	if len(placement) == 0 && len(s.Placement) == 0 || reflect.DeepEqual(placement, s.Placement) {
+		return false
+	}
+	s.Placement = placement
+	return true
+}
+
+// setPropagationPolicy sets status.propagationPolicy to the given
+// policy reference. Returns a boolean indication of whether
+// status.propagationPolicy was modified.
+func (s *GenericFederatedStatus) setPropagationPolicy(policy *util.PolicyReference) bool {
+	if reflect.DeepEqual(policy, s.PropagationPolicy) {
+		return false
+	}
+	s.PropagationPolicy = policy
+	return true
+}
+
+// setRollout sets status.rollout to the given rollout status. Returns
+// a boolean indication of whether status.rollout was modified.
+func (s *GenericFederatedStatus) setRollout(rollout *GenericRolloutStatus) bool {
+	if reflect.DeepEqual(rollout, s.Rollout) {
+		return false
+	}
+	s.Rollout = rollout
+	return true
+}
+
 // setPropagationCondition ensures that the Propagation condition is
 // updated to reflect the given reason.  The type of the condition is
 // derived from the reason (empty -> True, not empty -> False).
@@ -308,6 +491,105 @@ func (s *GenericFederatedStatus) setPropagationCondition(reason AggregateReason,
 	return updateRequired
 }
 
+// setPausedCondition ensures that the Paused condition reflects
+// whether propagation is paused. The condition is only added once
+// propagation has been paused. Returns a boolean indication of
+// whether the condition was modified.
+func (s *GenericFederatedStatus) setPausedCondition(paused bool) bool {
+	newStatus := apiv1.ConditionFalse
+	if paused {
+		newStatus = apiv1.ConditionTrue
+	}
+
+	var pausedCondition *GenericCondition
+	for _, condition := range s.Conditions {
+		if condition.Type == PausedConditionType {
+			pausedCondition = condition
+			break
+		}
+	}
+
+	if pausedCondition == nil {
+		if !paused {
+			return false
+		}
+		pausedCondition = &GenericCondition{
+			Type: PausedConditionType,
+		}
+		s.Conditions = append(s.Conditions, pausedCondition)
+	} else if pausedCondition.Status == newStatus {
+		return false
+	}
+
+	now := time.Now().UTC().Format(time.RFC3339)
+	pausedCondition.Status = newStatus
+	pausedCondition.LastTransitionTime = now
+	pausedCondition.LastUpdateTime = now
+	return true
+}
+
+// setReadyCondition ensures that the Ready condition reflects the
+// health of the resource in the selected clusters. The condition is
+// true if the resource is healthy in every selected cluster, and
+// otherwise false with a reason indicating whether the resource is
+// degraded in any cluster. The condition is unknown if the health
+// could not be determined. Returns a boolean indication of whether
+// the condition was modified.
+func (s *GenericFederatedStatus) setReadyCondition(health map[string]util.ResourceHealth, healthError string) bool {
+	if health == nil {
+		return false
+	}
+
+	newStatus := apiv1.ConditionTrue
+	reason := AggregateSuccess
+	for _, clusterHealth := range health {
+		switch clusterHealth {
+		case util.ResourceHealthy:
+			continue
+		case util.ResourceDegraded:
+			reason = ClustersDegraded
+		default:
+			if reason == AggregateSuccess {
+				reason = ClustersProgressing
+			}
+		}
+		newStatus = apiv1.ConditionFalse
+	}
+	if healthError != "" {
+		newStatus = apiv1.ConditionUnknown
+		reason = HealthCheckFailed
+	}
+
+	var readyCondition *GenericCondition
+	for _, condition := range s.Conditions {
+		if condition.Type == ReadyConditionType {
+			readyCondition = condition
+			break
+		}
+	}
+
+	if readyCondition == nil {
+		readyCondition = &GenericCondition{
+			Type: ReadyConditionType,
+		}
+		s.Conditions = append(s.Conditions, readyCondition)
+	} else if readyCondition.Status == newStatus && readyCondition.Reason == reason && readyCondition.Message == healthError {
+		return false
+	}
+
+	now := time.Now().UTC().Format(time.RFC3339)
+	// A change of reason or message, e.g. from progressing to
+	// degraded, is not a transition of the condition.
+	if readyCondition.Status != newStatus {
+		readyCondition.LastTransitionTime = now
+	}
+	readyCondition.Status = newStatus
+	readyCondition.Reason = reason
+	readyCondition.Message = healthError
+	readyCondition.LastUpdateTime = now
+	return true
+}
+
 func normalizeStatus(collectedResourceStatus CollectedResourceStatus) (*CollectedResourceStatus, error) {
 	if len(collectedResourceStatus.StatusMap) == 0 {
 		return &collectedResourceStatus, nil
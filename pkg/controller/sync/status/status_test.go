@@ -21,6 +21,8 @@ import (
 	"testing"
 
 	apiv1 "k8s.io/api/core/v1"
+
+	"sigs.k8s.io/kubefed/pkg/controller/util"
 )
 
 func TestGenericPropagationStatusUpdateChanged(t *testing.T) {
@@ -31,6 +33,13 @@ func TestGenericPropagationStatusUpdateChanged(t *testing.T) {
 		resourceStatusMap        map[string]interface{}
 		remoteStatus             interface{}
 		resourcesUpdated         bool
+		placement                []GenericClusterPlacement
+		placementDecisions       util.PlacementDecisions
+		propagationPolicy        *util.PolicyReference
+		collectedPolicy          *util.PolicyReference
+		paused                   bool
+		pausedCondition          *apiv1.ConditionStatus
+		health                   map[string]util.ResourceHealth
 		expectedChanged          bool
 		resourceStatusCollection bool
 	}{
@@ -170,6 +179,59 @@ func TestGenericPropagationStatusUpdateChanged(t *testing.T) {
 			resourceStatusCollection: false,
 			expectedChanged:          true,
 		},
+		"Change in placement decisions indicates changed": {
+			statusMap: PropagationStatusMap{
+				"cluster1": ClusterPropagationOK,
+			},
+			placement: []GenericClusterPlacement{
+				{Name: "cluster1", Reason: util.PlacementSelected},
+			},
+			placementDecisions: util.PlacementDecisions{
+				"cluster1": util.PlacementSelected,
+				"cluster2": util.PlacementNotSelected,
+			},
+			expectedChanged: true,
+		},
+		"No change in placement decisions indicates unchanged": {
+			statusMap: PropagationStatusMap{
+				"cluster1": ClusterPropagationOK,
+			},
+			placement: []GenericClusterPlacement{
+				{Name: "cluster1", Reason: util.PlacementSelected},
+				{Name: "cluster2", Reason: util.PlacementNotSelected},
+			},
+			placementDecisions: util.PlacementDecisions{
+				"cluster2": util.PlacementNotSelected,
+				"cluster1": util.PlacementSelected,
+			},
+			expectedChanged: false,
+		},
+		"Placement decisions that were not computed indicate unchanged": {
+			statusMap: PropagationStatusMap{
+				"cluster1": ClusterPropagationOK,
+			},
+			placement: []GenericClusterPlacement{
+				{Name: "cluster1", Reason: util.PlacementSelected},
+				{Name: "cluster2", Reason: util.PlacementNotSelected},
+			},
+			expectedChanged: false,
+		},
+		"Change in propagation policy indicates changed": {
+			statusMap: PropagationStatusMap{
+				"cluster1": ClusterPropagationOK,
+			},
+			propagationPolicy: &util.PolicyReference{Kind: util.PropagationPolicyKind, Namespace: "ns", Name: "p1"},
+			collectedPolicy:   &util.PolicyReference{Kind: util.ClusterPropagationPolicyKind, Name: "p1"},
+			expectedChanged:   true,
+		},
+		"No change in propagation policy indicates unchanged": {
+			statusMap: PropagationStatusMap{
+				"cluster1": ClusterPropagationOK,
+			},
+			propagationPolicy: &util.PolicyReference{Kind: util.PropagationPolicyKind, Namespace: "ns", Name: "p1"},
+			collectedPolicy:   &util.PolicyReference{Kind: util.PropagationPolicyKind, Namespace: "ns", Name: "p1"},
+			expectedChanged:   false,
+		},
 		"Transition indicates changed with remote status collection enabled": {
 			reason:                   NamespaceNotFederated,
 			resourceStatusCollection: true,
@@ -190,6 +252,45 @@ func TestGenericPropagationStatusUpdateChanged(t *testing.T) {
 			resourceStatusCollection: false,
 			expectedChanged:          true,
 		},
+		"Pausing propagation indicates changed": {
+			statusMap: PropagationStatusMap{
+				"cluster1": ClusterPropagationOK,
+			},
+			paused:          true,
+			expectedChanged: true,
+		},
+		"Continued pause of propagation indicates unchanged": {
+			statusMap: PropagationStatusMap{
+				"cluster1": ClusterPropagationOK,
+			},
+			paused:          true,
+			pausedCondition: conditionStatusPtr(apiv1.ConditionTrue),
+			expectedChanged: false,
+		},
+		"Resuming propagation indicates changed": {
+			statusMap: PropagationStatusMap{
+				"cluster1": ClusterPropagationOK,
+			},
+			paused:          false,
+			pausedCondition: conditionStatusPtr(apiv1.ConditionTrue),
+			expectedChanged: true,
+		},
+		"Propagation that was never paused indicates unchanged": {
+			statusMap: PropagationStatusMap{
+				"cluster1": ClusterPropagationOK,
+			},
+			paused:          false,
+			expectedChanged: false,
+		},
+		"Change in cluster health indicates changed": {
+			statusMap: PropagationStatusMap{
+				"cluster1": ClusterPropagationOK,
+			},
+			health: map[string]util.ResourceHealth{
+				"cluster1": util.ResourceProgressing,
+			},
+			expectedChanged: true,
+		},
 	}
 	for testName, tc := range testCases {
 		t.Run(testName, func(t *testing.T) {
@@ -206,10 +307,22 @@ func TestGenericPropagationStatusUpdateChanged(t *testing.T) {
 						Status: apiv1.ConditionTrue,
 					},
 				},
+				Placement:         tc.placement,
+				PropagationPolicy: tc.propagationPolicy,
+			}
+			if tc.pausedCondition != nil {
+				fedStatus.Conditions = append(fedStatus.Conditions, &GenericCondition{
+					Type:   PausedConditionType,
+					Status: *tc.pausedCondition,
+				})
 			}
 			collectedStatus := CollectedPropagationStatus{
-				StatusMap:        tc.statusMap,
-				ResourcesUpdated: tc.resourcesUpdated,
+				StatusMap:          tc.statusMap,
+				ResourcesUpdated:   tc.resourcesUpdated,
+				PlacementDecisions: tc.placementDecisions,
+				PropagationPolicy:  tc.collectedPolicy,
+				Paused:             tc.paused,
+				Health:             tc.health,
 			}
 			collectedResourceStatus := CollectedResourceStatus{
 				StatusMap:        tc.resourceStatusMap,
@@ -223,6 +336,121 @@ func TestGenericPropagationStatusUpdateChanged(t *testing.T) {
 	}
 }
 
+func TestSetReadyCondition(t *testing.T) {
+	lastTransitionTime := "2021-01-01T00:00:00Z"
+	testCases := map[string]struct {
+		health          map[string]util.ResourceHealth
+		healthError     string
+		readyCondition  *GenericCondition
+		expectedChanged bool
+		expectedStatus  apiv1.ConditionStatus
+		expectedReason  AggregateReason
+		// Whether the last transition time is expected to be
+		// updated.
+		expectedTransition bool
+	}{
+		"Unknown health does not add the condition": {
+			expectedChanged: false,
+		},
+		"Healthy resources in all clusters indicate ready": {
+			health: map[string]util.ResourceHealth{
+				"cluster1": util.ResourceHealthy,
+				"cluster2": util.ResourceHealthy,
+			},
+			expectedChanged:    true,
+			expectedStatus:     apiv1.ConditionTrue,
+			expectedTransition: true,
+		},
+		"Progressing resource indicates not ready": {
+			health: map[string]util.ResourceHealth{
+				"cluster1": util.ResourceHealthy,
+				"cluster2": util.ResourceProgressing,
+			},
+			expectedChanged:    true,
+			expectedStatus:     apiv1.ConditionFalse,
+			expectedReason:     ClustersProgressing,
+			expectedTransition: true,
+		},
+		"Degraded resource takes precedence over progressing resource": {
+			health: map[string]util.ResourceHealth{
+				"cluster1": util.ResourceDegraded,
+				"cluster2": util.ResourceProgressing,
+			},
+			readyCondition: &GenericCondition{
+				Type:               ReadyConditionType,
+				Status:             apiv1.ConditionFalse,
+				Reason:             ClustersProgressing,
+				LastTransitionTime: lastTransitionTime,
+			},
+			expectedChanged: true,
+			expectedStatus:  apiv1.ConditionFalse,
+			expectedReason:  ClustersDegraded,
+		},
+		"Unchanged health indicates unchanged": {
+			health: map[string]util.ResourceHealth{
+				"cluster1": util.ResourceHealthy,
+			},
+			readyCondition: &GenericCondition{
+				Type:   ReadyConditionType,
+				Status: apiv1.ConditionTrue,
+			},
+			expectedChanged: false,
+			expectedStatus:  apiv1.ConditionTrue,
+		},
+		"Failure to determine health indicates unknown": {
+			health: map[string]util.ResourceHealth{
+				"cluster1": util.ResourceProgressing,
+			},
+			healthError: "webhook unavailable",
+			readyCondition: &GenericCondition{
+				Type:               ReadyConditionType,
+				Status:             apiv1.ConditionTrue,
+				LastTransitionTime: lastTransitionTime,
+			},
+			expectedChanged:    true,
+			expectedStatus:     apiv1.ConditionUnknown,
+			expectedReason:     HealthCheckFailed,
+			expectedTransition: true,
+		},
+	}
+	for testName, tc := range testCases {
+		t.Run(testName, func(t *testing.T) {
+			fedStatus := &GenericFederatedStatus{}
+			if tc.readyCondition != nil {
+				fedStatus.Conditions = append(fedStatus.Conditions, tc.readyCondition)
+			}
+			changed := fedStatus.setReadyCondition(tc.health, tc.healthError)
+			if tc.expectedChanged != changed {
+				t.Fatalf("Expected changed to be %v, got %v", tc.expectedChanged, changed)
+			}
+			var readyCondition *GenericCondition
+			for _, condition := range fedStatus.Conditions {
+				if condition.Type == ReadyConditionType {
+					readyCondition = condition
+				}
+			}
+			if tc.health == nil {
+				if readyCondition != nil {
+					t.Fatalf("Expected no Ready condition, got %v", readyCondition)
+				}
+				return
+			}
+			if readyCondition == nil {
+				t.Fatalf("Expected a Ready condition")
+			}
+			if readyCondition.Status != tc.expectedStatus || readyCondition.Reason != tc.expectedReason {
+				t.Fatalf("Expected status %q and reason %q, got %q and %q", tc.expectedStatus, tc.expectedReason, readyCondition.Status, readyCondition.Reason)
+			}
+			if readyCondition.Message != tc.healthError {
+				t.Fatalf("Expected message %q, got %q", tc.healthError, readyCondition.Message)
+			}
+			if transitioned := readyCondition.LastTransitionTime != lastTransitionTime; tc.expectedChanged && transitioned != tc.expectedTransition {
+				t.Fatalf("Expected the last transition time to be updated: %v, got %q", tc.expectedTransition, readyCondition.LastTransitionTime)
+			}
+		})
+	}
+}
+
 func TestNormalizeStatus(t *testing.T) {
 	testCases := []struct {
 		name           string
@@ -280,3 +508,7 @@ func TestNormalizeStatus(t *testing.T) {
 		})
 	}
 }
+
+func conditionStatusPtr(status apiv1.ConditionStatus) *apiv1.ConditionStatus {
+	return &status
+}
@@ -0,0 +1,115 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sync
+
+import (
+	"sync"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/tools/cache"
+
+	"sigs.k8s.io/kubefed/pkg/apis/core/typeconfig"
+	"sigs.k8s.io/kubefed/pkg/controller/util"
+)
+
+// FederatedStores provides access to the informer stores of the
+// federated resources of the types sync controllers are running for.
+// This allows a sync controller to retrieve federated resources of
+// other types (e.g. the dependencies of a resource) without calls to
+// the API.
+type FederatedStores struct {
+	sync.RWMutex
+
+	stores map[schema.GroupKind]*federatedStore
+}
+
+type federatedStore struct {
+	store      cache.Store
+	namespaced bool
+}
+
+func NewFederatedStores() *FederatedStores {
+	return &FederatedStores{
+		stores: make(map[schema.GroupKind]*federatedStore),
+	}
+}
+
+// add registers the store of the federated type of the given type
+// config until the stop channel is closed.
+func (s *FederatedStores) add(typeConfig typeconfig.Interface, store cache.Store, stopChan <-chan struct{}) {
+	apiResource := typeConfig.GetFederatedType()
+	groupKind := apiResourceToGVK(&apiResource).GroupKind()
+	entry := &federatedStore{
+		store:      store,
+		namespaced: typeConfig.GetFederatedNamespaced(),
+	}
+	s.Lock()
+	s.stores[groupKind] = entry
+	s.Unlock()
+
+	go func() {
+		<-stopChan
+		s.Lock()
+		defer s.Unlock()
+		// The store may have been replaced by a sync controller
+		// started for the type since.
+		if s.stores[groupKind] == entry {
+			delete(s.stores, groupKind)
+		}
+	}()
+}
+
+// get retrieves the federated resource of the given kind. The
+// namespace is ignored for a cluster-scoped kind.
+func (s *FederatedStores) get(groupKind schema.GroupKind, qualifiedName util.QualifiedName) (*unstructured.Unstructured, error) {
+	s.RLock()
+	entry, ok := s.stores[groupKind]
+	s.RUnlock()
+	if !ok {
+		return nil, nil
+	}
+	if !entry.namespaced {
+		qualifiedName.Namespace = ""
+	}
+	return util.ObjFromCache(entry.store, groupKind.Kind, qualifiedName.String())
+}
+
+// visit calls the given function for each federated resource in the
+// given namespace, or in all namespaces if the namespace is empty.
+// Resources of cluster-scoped kinds are only visited for an empty
+// namespace.
+func (s *FederatedStores) visit(namespace string, visitFunc func(obj *unstructured.Unstructured)) {
+	s.RLock()
+	entries := make([]*federatedStore, 0, len(s.stores))
+	for _, entry := range s.stores {
+		entries = append(entries, entry)
+	}
+	s.RUnlock()
+	for _, entry := range entries {
+		if namespace != "" && !entry.namespaced {
+			continue
+		}
+		for _, rawObj := range entry.store.List() {
+			obj := rawObj.(*unstructured.Unstructured)
+			if namespace != "" && obj.GetNamespace() != namespace {
+				continue
+			}
+			visitFunc(obj)
+		}
+	}
+}
@@ -47,6 +47,7 @@ type VersionedResource interface {
 	Object() *unstructured.Unstructured
 	TemplateVersion() (string, error)
 	OverrideVersion() (string, error)
+	ClusterOverrideVersion(clusterName string) (string, error)
 }
 
 type VersionManager struct {
@@ -122,9 +123,17 @@ func (m *VersionManager) Get(resource VersionedResource) (map[string]string, err
 	if err != nil {
 		return nil, errors.Wrap(err, "Failed to determine override version")
 	}
-	if templateVersion == status.TemplateVersion &&
-		overrideVersion == status.OverrideVersion {
-		for _, versions := range status.ClusterVersions {
+	for _, versions := range status.ClusterVersions {
+		// Versions produced from a different template or from
+		// different overrides are no longer valid.
+		if clusterTemplateVersion(versions, status) != templateVersion {
+			continue
+		}
+		current, err := clusterOverridesCurrent(resource, versions, status, overrideVersion)
+		if err != nil {
+			return nil, err
+		}
+		if current {
 			versionMap[versions.ClusterName] = versions.Version
 		}
 	}
@@ -132,6 +141,40 @@ func (m *VersionManager) Get(resource VersionedResource) (map[string]string, err
 	return versionMap, nil
 }
 
+// GetTemplateVersions retrieves a mapping of cluster names to the
+// versions of the template last propagated to the cluster for the
+// given versioned resource. Clusters whose versions were recorded for
+// different overrides are omitted.
+func (m *VersionManager) GetTemplateVersions(resource VersionedResource) (map[string]string, error) {
+	templateVersionMap := make(map[string]string)
+
+	qualifiedName := m.versionQualifiedName(resource.FederatedName())
+	key := qualifiedName.String()
+	m.RLock()
+	obj, ok := m.versions[key]
+	m.RUnlock()
+	if !ok {
+		return templateVersionMap, nil
+	}
+	status := m.adapter.GetStatus(obj)
+
+	overrideVersion, err := resource.OverrideVersion()
+	if err != nil {
+		return nil, errors.Wrap(err, "Failed to determine override version")
+	}
+	for _, versions := range status.ClusterVersions {
+		current, err := clusterOverridesCurrent(resource, versions, status, overrideVersion)
+		if err != nil {
+			return nil, err
+		}
+		if current {
+			templateVersionMap[versions.ClusterName] = clusterTemplateVersion(versions, status)
+		}
+	}
+
+	return templateVersionMap, nil
+}
+
 // Update ensures that the propagated version for the given versioned
 // resource is recorded.
 func (m *VersionManager) Update(resource VersionedResource,
@@ -144,6 +187,16 @@ func (m *VersionManager) Update(resource VersionedResource,
 	if err != nil {
 		return errors.Wrap(err, "Failed to determine override version")
 	}
+	clusterOverrideVersions := make(map[string]string)
+	for clusterName, version := range versionMap {
+		if version == "" {
+			continue
+		}
+		clusterOverrideVersions[clusterName], err = resource.ClusterOverrideVersion(clusterName)
+		if err != nil {
+			return errors.Wrapf(err, "Failed to determine override version for cluster %q", clusterName)
+		}
+	}
 	qualifiedName := m.versionQualifiedName(resource.FederatedName())
 	key := qualifiedName.String()
 
@@ -155,13 +208,22 @@ func (m *VersionManager) Update(resource VersionedResource,
 	var clusterVersions []fedv1a1.ClusterObjectVersion
 	if ok {
 		oldStatus = m.adapter.GetStatus(obj)
-		// The existing versions are still valid if the template and override versions match.
-		if oldStatus.TemplateVersion == templateVersion && oldStatus.OverrideVersion == overrideVersion {
-			clusterVersions = oldStatus.ClusterVersions
+		// Since the template and override versions are recorded for
+		// each cluster, versions produced from a previous template
+		// or previous overrides remain identifiable until the
+		// clusters are updated. Versions recorded without a
+		// per-cluster override version are only retained if the
+		// override versions match.
+		for _, oldVersion := range oldStatus.ClusterVersions {
+			if oldVersion.OverrideVersion == "" && oldStatus.OverrideVersion != overrideVersion {
+				continue
+			}
+			oldVersion.TemplateVersion = clusterTemplateVersion(oldVersion, oldStatus)
+			clusterVersions = append(clusterVersions, oldVersion)
 		}
-		clusterVersions = updateClusterVersions(clusterVersions, versionMap, selectedClusters)
+		clusterVersions = updateClusterVersions(clusterVersions, versionMap, templateVersion, clusterOverrideVersions, selectedClusters)
 	} else {
-		clusterVersions = VersionMapToClusterVersions(versionMap)
+		clusterVersions = VersionMapToClusterVersions(versionMap, templateVersion, clusterOverrideVersions)
 	}
 
 	status := &fedv1a1.PropagatedVersionStatus{
@@ -425,8 +487,10 @@ func ownerReferenceForUnstructured(obj *unstructured.Unstructured) metav1.OwnerR
 	}
 }
 
-func updateClusterVersions(oldVersions []fedv1a1.ClusterObjectVersion,
-	newVersions map[string]string, selectedClusters []string) []fedv1a1.ClusterObjectVersion {
+func updateClusterVersions(oldVersions []fedv1a1.ClusterObjectVersion, newVersions map[string]string,
+	templateVersion string, clusterOverrideVersions map[string]string, selectedClusters []string) []fedv1a1.ClusterObjectVersion {
+	clusterVersions := VersionMapToClusterVersions(newVersions, templateVersion, clusterOverrideVersions)
+
 	// Retain versions for selected clusters that were not changed
 	selectedClusterSet := sets.NewString(selectedClusters...)
 	for _, oldVersion := range oldVersions {
@@ -434,14 +498,15 @@ func updateClusterVersions(oldVersions []fedv1a1.ClusterObjectVersion,
 			continue
 		}
 		if _, ok := newVersions[oldVersion.ClusterName]; !ok {
-			newVersions[oldVersion.ClusterName] = oldVersion.Version
+			clusterVersions = append(clusterVersions, oldVersion)
 		}
 	}
 
-	return VersionMapToClusterVersions(newVersions)
+	util.SortClusterVersions(clusterVersions)
+	return clusterVersions
 }
 
-func VersionMapToClusterVersions(versionMap map[string]string) []fedv1a1.ClusterObjectVersion {
+func VersionMapToClusterVersions(versionMap map[string]string, templateVersion string, clusterOverrideVersions map[string]string) []fedv1a1.ClusterObjectVersion {
 	clusterVersions := []fedv1a1.ClusterObjectVersion{}
 	for clusterName, version := range versionMap {
 		// Lack of version indicates deletion
@@ -449,10 +514,37 @@ func VersionMapToClusterVersions(versionMap map[string]string) []fedv1a1.Cluster
 			continue
 		}
 		clusterVersions = append(clusterVersions, fedv1a1.ClusterObjectVersion{
-			ClusterName: clusterName,
-			Version:     version,
+			ClusterName:     clusterName,
+			Version:         version,
+			TemplateVersion: templateVersion,
+			OverrideVersion: clusterOverrideVersions[clusterName],
 		})
 	}
 	util.SortClusterVersions(clusterVersions)
 	return clusterVersions
 }
+
+// clusterOverridesCurrent determines whether the given cluster version
+// was produced from the current overrides for its cluster. Versions
+// recorded without a per-cluster override version are current if
+// the override version of the status is current.
+func clusterOverridesCurrent(resource VersionedResource, clusterVersion fedv1a1.ClusterObjectVersion,
+	status *fedv1a1.PropagatedVersionStatus, overrideVersion string) (bool, error) {
+	if clusterVersion.OverrideVersion == "" {
+		return status.OverrideVersion == overrideVersion, nil
+	}
+	clusterOverrideVersion, err := resource.ClusterOverrideVersion(clusterVersion.ClusterName)
+	if err != nil {
+		return false, errors.Wrapf(err, "Failed to determine override version for cluster %q", clusterVersion.ClusterName)
+	}
+	return clusterVersion.OverrideVersion == clusterOverrideVersion, nil
+}
+
+// clusterTemplateVersion returns the version of the template the
+// given cluster version was produced from.
+func clusterTemplateVersion(clusterVersion fedv1a1.ClusterObjectVersion, status *fedv1a1.PropagatedVersionStatus) string {
+	if clusterVersion.TemplateVersion != "" {
+		return clusterVersion.TemplateVersion
+	}
+	return status.TemplateVersion
+}
@@ -50,8 +50,9 @@ const (
 
 // BuildClusterConfig returns a restclient.Config that can be used to configure
 // a client for the given KubeFedCluster or an error. The client is used to
-// access kubernetes secrets in the kubefed namespace.
-func BuildClusterConfig(fedCluster *fedv1b1.KubeFedCluster, client generic.Client, fedNamespace string) (*restclient.Config, error) {
+// access kubernetes secrets in the kubefed namespace. The rate limit of the
+// KubeFedCluster defaults to the given rate limit.
+func BuildClusterConfig(fedCluster *fedv1b1.KubeFedCluster, client generic.Client, fedNamespace string, rateLimit ClusterRateLimitConfig) (*restclient.Config, error) {
 	clusterName := fedCluster.Name
 
 	apiEndpoint := fedCluster.Spec.APIEndpoint
@@ -81,8 +82,7 @@ func BuildClusterConfig(fedCluster *fedv1b1.KubeFedCluster, client generic.Clien
 	}
 	clusterConfig.CAData = fedCluster.Spec.CABundle
 	clusterConfig.BearerToken = string(token)
-	clusterConfig.QPS = KubeAPIQPS
-	clusterConfig.Burst = KubeAPIBurst
+	clusterConfig.QPS, clusterConfig.Burst = ClusterRateLimit(fedCluster, rateLimit)
 
 	if fedCluster.Spec.ProxyURL != "" {
 		proxyURL, err := url.Parse(fedCluster.Spec.ProxyURL)
@@ -102,6 +102,27 @@ func BuildClusterConfig(fedCluster *fedv1b1.KubeFedCluster, client generic.Clien
 	return clusterConfig, nil
 }
 
+// ClusterRateLimit returns the QPS and burst of the clients of the
+// given KubeFedCluster. Values not specified by the cluster are taken
+// from the given rate limit, or from the KubeAPIQPS and KubeAPIBurst
+// constants if the rate limit does not specify them either.
+func ClusterRateLimit(fedCluster *fedv1b1.KubeFedCluster, rateLimit ClusterRateLimitConfig) (float32, int) {
+	qps, burst := rateLimit.QPS, rateLimit.Burst
+	if qps <= 0 {
+		qps = KubeAPIQPS
+	}
+	if burst <= 0 {
+		burst = KubeAPIBurst
+	}
+	if fedCluster.Spec.QPS != nil {
+		qps = float32(*fedCluster.Spec.QPS)
+	}
+	if fedCluster.Spec.Burst != nil {
+		burst = int(*fedCluster.Spec.Burst)
+	}
+	return qps, burst
+}
+
 // IsPrimaryCluster checks if the caller is working with objects for the
 // primary cluster by checking if the UIDs match for both ObjectMetas passed
 // in.
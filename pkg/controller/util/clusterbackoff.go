@@ -0,0 +1,108 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package util
+
+import (
+	"sync"
+	"time"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/client-go/util/flowcontrol"
+)
+
+// ClusterBackoff tracks the member clusters that have throttled
+// requests (i.e. responded with 429 Too Many Requests) so that
+// further requests to them can be delayed rather than retried
+// immediately. The backoff of a cluster doubles each time the cluster
+// throttles a request during its backoff, up to a maximum, and is
+// reset once the cluster has not throttled a request for twice the
+// maximum.
+type ClusterBackoff struct {
+	sync.Mutex
+
+	backoff *flowcontrol.Backoff
+
+	// The time until which requests to a cluster are delayed, keyed
+	// by cluster name.
+	until map[string]time.Time
+}
+
+func NewClusterBackoff(initial, max time.Duration) *ClusterBackoff {
+	return newClusterBackoff(flowcontrol.NewBackOff(initial, max))
+}
+
+func newClusterBackoff(backoff *flowcontrol.Backoff) *ClusterBackoff {
+	return &ClusterBackoff{
+		backoff: backoff,
+		until:   make(map[string]time.Time),
+	}
+}
+
+// Throttled records that the named cluster throttled a request with
+// the given error and returns the time to wait before sending
+// further requests to the cluster. A delay suggested by the cluster
+// is honored if it is longer than the backoff.
+func (b *ClusterBackoff) Throttled(clusterName string, err error) time.Duration {
+	b.Lock()
+	defer b.Unlock()
+
+	now := b.backoff.Clock.Now()
+	b.backoff.Next(clusterName, now)
+	delay := b.backoff.Get(clusterName)
+	if seconds, ok := apierrors.SuggestsClientDelay(err); ok {
+		if suggested := time.Duration(seconds) * time.Second; suggested > delay {
+			delay = suggested
+		}
+	}
+	if until := now.Add(delay); until.After(b.until[clusterName]) {
+		b.until[clusterName] = until
+	}
+	return delay
+}
+
+// Remaining returns the time remaining until requests may be sent to
+// the named cluster again, or zero if requests are not delayed.
+func (b *ClusterBackoff) Remaining(clusterName string) time.Duration {
+	b.Lock()
+	defer b.Unlock()
+
+	until, ok := b.until[clusterName]
+	if !ok {
+		return 0
+	}
+	remaining := until.Sub(b.backoff.Clock.Now())
+	if remaining <= 0 {
+		delete(b.until, clusterName)
+		return 0
+	}
+	return remaining
+}
+
+// GC removes elapsed backoffs and resets the backoff of clusters that
+// have not throttled a request for twice the maximum backoff.
+func (b *ClusterBackoff) GC() {
+	b.Lock()
+	defer b.Unlock()
+
+	now := b.backoff.Clock.Now()
+	for clusterName, until := range b.until {
+		if !until.After(now) {
+			delete(b.until, clusterName)
+		}
+	}
+	b.backoff.GC()
+}
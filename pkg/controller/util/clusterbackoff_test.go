@@ -0,0 +1,64 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package util
+
+import (
+	"testing"
+	"time"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/util/clock"
+	"k8s.io/client-go/util/flowcontrol"
+)
+
+func TestClusterBackoff(t *testing.T) {
+	fakeClock := clock.NewFakeClock(time.Now())
+	backoff := newClusterBackoff(flowcontrol.NewFakeBackOff(time.Second, 10*time.Second, fakeClock))
+	throttledErr := apierrors.NewTooManyRequests("throttled", 0)
+
+	if remaining := backoff.Remaining("cluster1"); remaining != 0 {
+		t.Fatalf("Expected no backoff for a cluster that did not throttle, got %v", remaining)
+	}
+
+	for _, expected := range []time.Duration{time.Second, 2 * time.Second, 4 * time.Second} {
+		if delay := backoff.Throttled("cluster1", throttledErr); delay != expected {
+			t.Fatalf("Expected a delay of %v, got %v", expected, delay)
+		}
+	}
+	if remaining := backoff.Remaining("cluster1"); remaining != 4*time.Second {
+		t.Fatalf("Expected a remaining backoff of %v, got %v", 4*time.Second, remaining)
+	}
+	if remaining := backoff.Remaining("cluster2"); remaining != 0 {
+		t.Fatalf("Expected the backoff of a cluster to not affect other clusters, got %v", remaining)
+	}
+
+	fakeClock.Step(4 * time.Second)
+	if remaining := backoff.Remaining("cluster1"); remaining != 0 {
+		t.Fatalf("Expected the backoff to have elapsed, got %v", remaining)
+	}
+
+	// A longer delay suggested by the cluster is honored.
+	if delay := backoff.Throttled("cluster2", apierrors.NewTooManyRequests("throttled", 30)); delay != 30*time.Second {
+		t.Fatalf("Expected the suggested delay of %v, got %v", 30*time.Second, delay)
+	}
+
+	// The backoff is reset once a cluster stops throttling requests.
+	fakeClock.Step(time.Minute)
+	if delay := backoff.Throttled("cluster1", throttledErr); delay != time.Second {
+		t.Fatalf("Expected the backoff to have been reset to %v, got %v", time.Second, delay)
+	}
+}
@@ -33,6 +33,10 @@ const (
 
 	ServiceAccountKind = "ServiceAccount"
 
+	// The field manager of the fields of member cluster resources
+	// propagated with server-side apply.
+	FieldManager = "kubefed"
+
 	// The following fields are used to interact with unstructured
 	// resources.
 
@@ -58,17 +62,28 @@ const (
 	TemplateField = "template"
 
 	// Placement fields
-	PlacementField       = "placement"
-	ClusterSelectorField = "clusterSelector"
-	MatchLabelsField     = "matchLabels"
+	PlacementField        = "placement"
+	ClusterSelectorField  = "clusterSelector"
+	MatchLabelsField      = "matchLabels"
+	SpreadConstraintField = "spreadConstraint"
+	TolerationsField      = "tolerations"
+	FailoverField         = "failover"
+	MaxClustersField      = "maxClusters"
 
 	// Override fields
 	OverridesField        = "overrides"
 	ClusterNameField      = "clusterName"
 	ClusterOverridesField = "clusterOverrides"
+	PatchTypeField        = "patchType"
 	PathField             = "path"
 	ValueField            = "value"
 
+	// Rollout fields
+	RolloutStrategyField = "rolloutStrategy"
+
+	// Dependency fields
+	DependsOnField = "dependsOn"
+
 	// Cluster reference
 	ClustersField = "clusters"
 	NameField     = "name"
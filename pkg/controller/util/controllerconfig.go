@@ -56,6 +56,13 @@ type KubeFedNamespaces struct {
 	TargetNamespace  string
 }
 
+// ClusterRateLimitConfig defines the rate limit of the clients of
+// member clusters that do not specify their own.
+type ClusterRateLimitConfig struct {
+	QPS   float32
+	Burst int
+}
+
 // ClusterHealthCheckConfig defines the configurable parameters for cluster health check
 type ClusterHealthCheckConfig struct {
 	Period           time.Duration
@@ -77,6 +84,12 @@ type ControllerConfig struct {
 	MaxConcurrentStatusReconciles int64
 	SkipAdoptingResources         bool
 	RawResourceStatusCollection   bool
+	OperationTimeout              time.Duration
+	MaxInFlightOperations         int64
+	ClusterRateLimit              ClusterRateLimitConfig
+	// PolicyInformers provides the propagation and override policies
+	// to the sync controllers of all federated types.
+	PolicyInformers *PolicyInformers
 }
 
 func (c *ControllerConfig) LimitedScope() bool {
@@ -0,0 +1,165 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package util
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/sets"
+)
+
+// GenericDependency references a federated resource whose resources
+// must be propagated to a cluster before the resource of the
+// dependent federated resource is created in the cluster.  A
+// namespaced dependency must be in the namespace of the dependent.
+type GenericDependency struct {
+	// APIVersion of the dependency. Defaults to the API version of
+	// the dependent.
+	APIVersion string `json:"apiVersion,omitempty"`
+	Kind       string `json:"kind"`
+	Name       string `json:"name"`
+}
+
+type GenericDependencySpec struct {
+	DependsOn []GenericDependency `json:"dependsOn,omitempty"`
+}
+
+type GenericDependent struct {
+	Spec GenericDependencySpec `json:"spec,omitempty"`
+}
+
+// GetDependencies returns the dependencies of the given federated
+// resource.
+func GetDependencies(obj *unstructured.Unstructured) ([]GenericDependency, error) {
+	dependent := &GenericDependent{}
+	err := UnstructuredToInterface(obj, dependent)
+	if err != nil {
+		return nil, err
+	}
+	for i, dependency := range dependent.Spec.DependsOn {
+		if len(dependency.Kind) == 0 || len(dependency.Name) == 0 {
+			return nil, errors.Errorf("dependsOn[%d] must specify a kind and a name", i)
+		}
+		if _, err := schema.ParseGroupVersion(dependency.APIVersion); err != nil {
+			return nil, errors.Wrapf(err, "dependsOn[%d] has an invalid api version", i)
+		}
+	}
+	return dependent.Spec.DependsOn, nil
+}
+
+// GroupVersionKind returns the group, version and kind of the
+// dependency of the given dependent.
+func (d GenericDependency) GroupVersionKind(dependent *unstructured.Unstructured) schema.GroupVersionKind {
+	apiVersion := d.APIVersion
+	if len(apiVersion) == 0 {
+		apiVersion = dependent.GetAPIVersion()
+	}
+	return schema.FromAPIVersionAndKind(apiVersion, d.Kind)
+}
+
+func (d GenericDependency) String() string {
+	return fmt.Sprintf("%s %q", d.Kind, d.Name)
+}
+
+// DependsOn checks whether the given dependent federated resource
+// declares a dependency on the given federated resource.
+func DependsOn(dependent, obj *unstructured.Unstructured) (bool, error) {
+	dependencies, err := GetDependencies(dependent)
+	if err != nil {
+		return false, err
+	}
+	for _, dependency := range dependencies {
+		if dependency.refersTo(dependent, obj) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// refersTo checks whether the dependency of the given dependent
+// references the given federated resource.
+func (d GenericDependency) refersTo(dependent, obj *unstructured.Unstructured) bool {
+	if len(obj.GetNamespace()) > 0 && obj.GetNamespace() != dependent.GetNamespace() {
+		return false
+	}
+	gvk := d.GroupVersionKind(dependent)
+	objGVK := obj.GroupVersionKind()
+	return d.Name == obj.GetName() && gvk.Kind == objGVK.Kind && gvk.Group == objGVK.Group
+}
+
+// GetDependencyFunc retrieves the federated resource referenced by
+// the dependency of the given dependent, or returns nil if it does
+// not exist.
+type GetDependencyFunc func(dependent *unstructured.Unstructured, dependency GenericDependency) (*unstructured.Unstructured, error)
+
+// FindDependencyCycle follows the dependencies of the given federated
+// resource, retrieving them with the given function, and returns the
+// chain of dependencies that leads back to the resource. Nil is
+// returned if the resource does not depend on itself. Dependencies of
+// retrieved resources that are invalid are ignored.
+func FindDependencyCycle(obj *unstructured.Unstructured, getDependency GetDependencyFunc) ([]GenericDependency, error) {
+	visited := sets.NewString()
+	var visit func(dependent *unstructured.Unstructured, chain []GenericDependency) ([]GenericDependency, error)
+	visit = func(dependent *unstructured.Unstructured, chain []GenericDependency) ([]GenericDependency, error) {
+		dependencies, err := GetDependencies(dependent)
+		if err != nil {
+			if dependent == obj {
+				return nil, err
+			}
+			return nil, nil
+		}
+		for _, dependency := range dependencies {
+			dependencyChain := append(chain[:len(chain):len(chain)], dependency)
+			if dependency.refersTo(dependent, obj) {
+				return dependencyChain, nil
+			}
+			dependencyObj, err := getDependency(dependent, dependency)
+			if err != nil {
+				return nil, errors.Wrapf(err, "Failed to retrieve dependency %s", dependency)
+			}
+			if dependencyObj == nil {
+				continue
+			}
+			key := fmt.Sprintf("%s/%s", dependencyObj.GroupVersionKind().GroupKind(), NewQualifiedName(dependencyObj))
+			if visited.Has(key) {
+				continue
+			}
+			visited.Insert(key)
+			cycle, err := visit(dependencyObj, dependencyChain)
+			if err != nil || cycle != nil {
+				return cycle, err
+			}
+		}
+		return nil, nil
+	}
+	return visit(obj, nil)
+}
+
+// DependencyChainString describes the given chain of dependencies
+// starting from the given federated resource.
+func DependencyChainString(obj *unstructured.Unstructured, chain []GenericDependency) string {
+	descriptions := []string{fmt.Sprintf("%s %q", obj.GetKind(), obj.GetName())}
+	for _, dependency := range chain {
+		descriptions = append(descriptions, dependency.String())
+	}
+	return strings.Join(descriptions, " -> ")
+}
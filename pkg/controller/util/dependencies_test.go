@@ -0,0 +1,200 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package util
+
+import (
+	"strings"
+	"testing"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func newDependent(namespace string, dependsOn ...interface{}) *unstructured.Unstructured {
+	obj := &unstructured.Unstructured{Object: map[string]interface{}{
+		"spec": map[string]interface{}{
+			DependsOnField: dependsOn,
+		},
+	}}
+	obj.SetAPIVersion("types.kubefed.io/v1beta1")
+	obj.SetKind("FederatedDeployment")
+	obj.SetNamespace(namespace)
+	obj.SetName("dependent")
+	return obj
+}
+
+func TestGetDependencies(t *testing.T) {
+	testCases := map[string]struct {
+		dependency  map[string]interface{}
+		expectedErr bool
+	}{
+		"Dependency without api version is valid": {
+			dependency: map[string]interface{}{"kind": "FederatedConfigMap", "name": "cm"},
+		},
+		"Dependency with api version is valid": {
+			dependency: map[string]interface{}{"apiVersion": "types.kubefed.io/v1beta1", "kind": "FederatedConfigMap", "name": "cm"},
+		},
+		"Dependency without name is invalid": {
+			dependency:  map[string]interface{}{"kind": "FederatedConfigMap"},
+			expectedErr: true,
+		},
+		"Dependency with malformed api version is invalid": {
+			dependency:  map[string]interface{}{"apiVersion": "a/b/c", "kind": "FederatedConfigMap", "name": "cm"},
+			expectedErr: true,
+		},
+	}
+	for testName, tc := range testCases {
+		t.Run(testName, func(t *testing.T) {
+			_, err := GetDependencies(newDependent("ns", tc.dependency))
+			if tc.expectedErr && err == nil {
+				t.Fatalf("Expected an error")
+			}
+			if !tc.expectedErr && err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+		})
+	}
+}
+
+func TestDependsOn(t *testing.T) {
+	dependency := map[string]interface{}{"kind": "FederatedConfigMap", "name": "cm"}
+	testCases := map[string]struct {
+		namespace      string
+		apiVersion     string
+		kind           string
+		expectedResult bool
+	}{
+		"Dependency in the same namespace matches": {
+			namespace:      "ns",
+			apiVersion:     "types.kubefed.io/v1beta1",
+			kind:           "FederatedConfigMap",
+			expectedResult: true,
+		},
+		"Dependency with a different version matches": {
+			namespace:      "ns",
+			apiVersion:     "types.kubefed.io/v1",
+			kind:           "FederatedConfigMap",
+			expectedResult: true,
+		},
+		"Dependency in a different namespace does not match": {
+			namespace:  "other",
+			apiVersion: "types.kubefed.io/v1beta1",
+			kind:       "FederatedConfigMap",
+		},
+		"Dependency of a different group does not match": {
+			namespace:  "ns",
+			apiVersion: "example.io/v1beta1",
+			kind:       "FederatedConfigMap",
+		},
+		"Dependency of a different kind does not match": {
+			namespace:  "ns",
+			apiVersion: "types.kubefed.io/v1beta1",
+			kind:       "FederatedSecret",
+		},
+	}
+	for testName, tc := range testCases {
+		t.Run(testName, func(t *testing.T) {
+			obj := &unstructured.Unstructured{Object: map[string]interface{}{}}
+			obj.SetAPIVersion(tc.apiVersion)
+			obj.SetKind(tc.kind)
+			obj.SetNamespace(tc.namespace)
+			obj.SetName("cm")
+			result, err := DependsOn(newDependent("ns", dependency), obj)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if result != tc.expectedResult {
+				t.Fatalf("Expected %v, got %v", tc.expectedResult, result)
+			}
+		})
+	}
+}
+
+func TestFindDependencyCycle(t *testing.T) {
+	newObj := func(kind, name string, dependsOn ...string) *unstructured.Unstructured {
+		var dependencies []interface{}
+		for _, dependency := range dependsOn {
+			fields := strings.Split(dependency, "/")
+			dependencies = append(dependencies, map[string]interface{}{"kind": fields[0], "name": fields[1]})
+		}
+		obj := newDependent("ns", dependencies...)
+		obj.SetKind(kind)
+		obj.SetName(name)
+		return obj
+	}
+
+	testCases := map[string]struct {
+		obj           *unstructured.Unstructured
+		objs          []*unstructured.Unstructured
+		expectedChain string
+	}{
+		"No dependencies": {
+			obj: newObj("FederatedDeployment", "app"),
+		},
+		"Dependency that does not exist": {
+			obj: newObj("FederatedDeployment", "app", "FederatedConfigMap/cm"),
+		},
+		"Dependencies without a cycle": {
+			obj: newObj("FederatedDeployment", "app", "FederatedConfigMap/cm", "FederatedSecret/secret"),
+			objs: []*unstructured.Unstructured{
+				newObj("FederatedConfigMap", "cm", "FederatedSecret/secret"),
+				newObj("FederatedSecret", "secret"),
+			},
+		},
+		"Self-reference": {
+			obj:           newObj("FederatedDeployment", "app", "FederatedDeployment/app"),
+			expectedChain: `FederatedDeployment "app" -> FederatedDeployment "app"`,
+		},
+		"Cycle through dependencies": {
+			obj: newObj("FederatedDeployment", "app", "FederatedConfigMap/cm"),
+			objs: []*unstructured.Unstructured{
+				newObj("FederatedConfigMap", "cm", "FederatedSecret/secret"),
+				newObj("FederatedSecret", "secret", "FederatedDeployment/app"),
+			},
+			expectedChain: `FederatedDeployment "app" -> FederatedConfigMap "cm" -> FederatedSecret "secret" -> FederatedDeployment "app"`,
+		},
+		"Cycle of dependencies that does not include the resource": {
+			obj: newObj("FederatedDeployment", "app", "FederatedConfigMap/cm"),
+			objs: []*unstructured.Unstructured{
+				newObj("FederatedConfigMap", "cm", "FederatedSecret/secret"),
+				newObj("FederatedSecret", "secret", "FederatedConfigMap/cm"),
+			},
+		},
+	}
+	for testName, tc := range testCases {
+		t.Run(testName, func(t *testing.T) {
+			getDependency := func(dependent *unstructured.Unstructured, dependency GenericDependency) (*unstructured.Unstructured, error) {
+				for _, obj := range tc.objs {
+					if dependency.refersTo(dependent, obj) {
+						return obj, nil
+					}
+				}
+				return nil, nil
+			}
+			chain, err := FindDependencyCycle(tc.obj, getDependency)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			var chainString string
+			if chain != nil {
+				chainString = DependencyChainString(tc.obj, chain)
+			}
+			if chainString != tc.expectedChain {
+				t.Fatalf("Expected cycle %q, got %q", tc.expectedChain, chainString)
+			}
+		})
+	}
+}
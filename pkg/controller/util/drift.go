@@ -0,0 +1,192 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package util
+
+import (
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+// DriftedPaths returns the JSON pointers of the fields of the desired
+// object whose values differ in the cluster object. Fields that are
+// only present in the cluster object (e.g. those defaulted by the API
+// server or set by controllers in the member cluster) are ignored, as
+// are the status and the metadata other than labels and annotations.
+func DriftedPaths(desiredObj, clusterObj *unstructured.Unstructured) []string {
+	var paths []string
+	for key, desiredValue := range desiredObj.Object {
+		switch key {
+		case "apiVersion", "kind", StatusField:
+			continue
+		case MetadataField:
+			for _, field := range []string{"labels", "annotations"} {
+				desiredField, _, _ := unstructured.NestedFieldNoCopy(desiredObj.Object, MetadataField, field)
+				clusterField, _, _ := unstructured.NestedFieldNoCopy(clusterObj.Object, MetadataField, field)
+				paths = appendDriftedPaths(paths, "/"+MetadataField+"/"+field, desiredField, clusterField)
+			}
+		default:
+			paths = appendDriftedPaths(paths, "/"+escapeJSONPointer(key), desiredValue, clusterObj.Object[key])
+		}
+	}
+	sort.Strings(paths)
+	return paths
+}
+
+func appendDriftedPaths(paths []string, path string, desiredValue, clusterValue interface{}) []string {
+	switch desired := desiredValue.(type) {
+	case map[string]interface{}:
+		if len(desired) == 0 && clusterValue == nil {
+			return paths
+		}
+		cluster, ok := clusterValue.(map[string]interface{})
+		if !ok {
+			return append(paths, path)
+		}
+		for key, value := range desired {
+			paths = appendDriftedPaths(paths, path+"/"+escapeJSONPointer(key), value, cluster[key])
+		}
+		return paths
+	case []interface{}:
+		if len(desired) == 0 && clusterValue == nil {
+			return paths
+		}
+		cluster, ok := clusterValue.([]interface{})
+		if !ok || len(cluster) != len(desired) {
+			return append(paths, path)
+		}
+		for i, value := range desired {
+			paths = appendDriftedPaths(paths, path+"/"+strconv.Itoa(i), value, cluster[i])
+		}
+		return paths
+	}
+	if !scalarsEqual(desiredValue, clusterValue) {
+		return append(paths, path)
+	}
+	return paths
+}
+
+// ComparedFields returns a copy of the cluster object limited to its
+// api version, kind, name, namespace and the fields that DriftedPaths
+// compares with the desired object. Elements of a list in the cluster
+// object beyond the length of the desired list are copied whole.
+func ComparedFields(desiredObj, clusterObj *unstructured.Unstructured) *unstructured.Unstructured {
+	metadata := map[string]interface{}{
+		"name": clusterObj.GetName(),
+	}
+	if namespace := clusterObj.GetNamespace(); namespace != "" {
+		metadata["namespace"] = namespace
+	}
+	obj := &unstructured.Unstructured{Object: map[string]interface{}{
+		MetadataField: metadata,
+	}}
+	for _, key := range []string{"apiVersion", "kind"} {
+		if value, ok := clusterObj.Object[key]; ok {
+			obj.Object[key] = value
+		}
+	}
+	for key, desiredValue := range desiredObj.Object {
+		switch key {
+		case "apiVersion", "kind", StatusField:
+			continue
+		case MetadataField:
+			for _, field := range []string{"labels", "annotations"} {
+				desiredField, _, _ := unstructured.NestedFieldNoCopy(desiredObj.Object, MetadataField, field)
+				clusterField, ok, _ := unstructured.NestedFieldNoCopy(clusterObj.Object, MetadataField, field)
+				if !ok {
+					continue
+				}
+				metadata[field] = comparedValue(desiredField, clusterField)
+			}
+		default:
+			if clusterValue, ok := clusterObj.Object[key]; ok {
+				obj.Object[key] = comparedValue(desiredValue, clusterValue)
+			}
+		}
+	}
+	return obj
+}
+
+func comparedValue(desiredValue, clusterValue interface{}) interface{} {
+	switch desired := desiredValue.(type) {
+	case map[string]interface{}:
+		cluster, ok := clusterValue.(map[string]interface{})
+		if !ok {
+			return comparedValue(clusterValue, clusterValue)
+		}
+		value := make(map[string]interface{})
+		for key, desiredField := range desired {
+			if clusterField, ok := cluster[key]; ok {
+				value[key] = comparedValue(desiredField, clusterField)
+			}
+		}
+		return value
+	case []interface{}:
+		cluster, ok := clusterValue.([]interface{})
+		if !ok {
+			return comparedValue(clusterValue, clusterValue)
+		}
+		value := make([]interface{}, len(cluster))
+		for i := range cluster {
+			if i < len(desired) {
+				value[i] = comparedValue(desired[i], cluster[i])
+			} else {
+				value[i] = comparedValue(cluster[i], cluster[i])
+			}
+		}
+		return value
+	}
+	switch clusterValue.(type) {
+	case map[string]interface{}, []interface{}:
+		return comparedValue(clusterValue, clusterValue)
+	}
+	// Scalars are immutable and can be shared.
+	return clusterValue
+}
+
+// scalarsEqual compares scalar values, treating numbers of different
+// types as equal if their values are equal.
+func scalarsEqual(a, b interface{}) bool {
+	aNumber, aIsNumber := toFloat64(a)
+	bNumber, bIsNumber := toFloat64(b)
+	if aIsNumber && bIsNumber {
+		return aNumber == bNumber
+	}
+	return reflect.DeepEqual(a, b)
+}
+
+func toFloat64(value interface{}) (float64, bool) {
+	switch number := value.(type) {
+	case int64:
+		return float64(number), true
+	case int32:
+		return float64(number), true
+	case int:
+		return float64(number), true
+	case float64:
+		return number, true
+	}
+	return 0, false
+}
+
+func escapeJSONPointer(field string) string {
+	field = strings.ReplaceAll(field, "~", "~0")
+	return strings.ReplaceAll(field, "/", "~1")
+}
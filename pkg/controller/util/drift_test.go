@@ -0,0 +1,271 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package util
+
+import (
+	"reflect"
+	"testing"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func TestDriftedPaths(t *testing.T) {
+	testCases := map[string]struct {
+		desired       map[string]interface{}
+		cluster       map[string]interface{}
+		expectedPaths []string
+	}{
+		"No drift when the cluster object matches": {
+			desired: map[string]interface{}{
+				"spec": map[string]interface{}{
+					"replicas": int64(1),
+				},
+			},
+			cluster: map[string]interface{}{
+				"spec": map[string]interface{}{
+					"replicas": int64(1),
+				},
+			},
+		},
+		"Fields only in the cluster object, status and other metadata are ignored": {
+			desired: map[string]interface{}{
+				"metadata": map[string]interface{}{
+					"name": "foo",
+				},
+				"spec": map[string]interface{}{},
+			},
+			cluster: map[string]interface{}{
+				"metadata": map[string]interface{}{
+					"name":            "foo",
+					"resourceVersion": "2",
+				},
+				"spec": map[string]interface{}{
+					"clusterIP": "10.0.0.1",
+				},
+				"status": map[string]interface{}{
+					"ready": true,
+				},
+			},
+		},
+		"Numbers of different types are compared by value": {
+			desired: map[string]interface{}{
+				"spec": map[string]interface{}{
+					"replicas": int64(2),
+				},
+			},
+			cluster: map[string]interface{}{
+				"spec": map[string]interface{}{
+					"replicas": float64(2),
+				},
+			},
+		},
+		"Differing and missing fields are reported": {
+			desired: map[string]interface{}{
+				"metadata": map[string]interface{}{
+					"labels": map[string]interface{}{
+						"app.kubernetes.io/name": "foo",
+					},
+				},
+				"data": map[string]interface{}{
+					"a": "1",
+					"b": "2",
+				},
+			},
+			cluster: map[string]interface{}{
+				"metadata": map[string]interface{}{
+					"labels": map[string]interface{}{
+						"app.kubernetes.io/name": "bar",
+					},
+				},
+				"data": map[string]interface{}{
+					"a": "3",
+				},
+			},
+			expectedPaths: []string{
+				"/data/a",
+				"/data/b",
+				"/metadata/labels/app.kubernetes.io~1name",
+			},
+		},
+		"Lists are compared by index": {
+			desired: map[string]interface{}{
+				"spec": map[string]interface{}{
+					"containers": []interface{}{
+						map[string]interface{}{
+							"name":  "foo",
+							"image": "foo:v2",
+						},
+					},
+				},
+			},
+			cluster: map[string]interface{}{
+				"spec": map[string]interface{}{
+					"containers": []interface{}{
+						map[string]interface{}{
+							"name":  "foo",
+							"image": "foo:v1",
+						},
+					},
+				},
+			},
+			expectedPaths: []string{
+				"/spec/containers/0/image",
+			},
+		},
+		"Lists of different lengths are reported as a whole": {
+			desired: map[string]interface{}{
+				"spec": map[string]interface{}{
+					"ports": []interface{}{int64(80), int64(443)},
+				},
+			},
+			cluster: map[string]interface{}{
+				"spec": map[string]interface{}{
+					"ports": []interface{}{int64(80)},
+				},
+			},
+			expectedPaths: []string{
+				"/spec/ports",
+			},
+		},
+	}
+	for testName, tc := range testCases {
+		t.Run(testName, func(t *testing.T) {
+			desiredObj := &unstructured.Unstructured{Object: tc.desired}
+			clusterObj := &unstructured.Unstructured{Object: tc.cluster}
+			paths := DriftedPaths(desiredObj, clusterObj)
+			if !reflect.DeepEqual(paths, tc.expectedPaths) {
+				t.Fatalf("Expected paths %v, got %v", tc.expectedPaths, paths)
+			}
+		})
+	}
+}
+
+func TestComparedFields(t *testing.T) {
+	testCases := map[string]struct {
+		desired  map[string]interface{}
+		cluster  map[string]interface{}
+		expected map[string]interface{}
+	}{
+		"Fields only in the cluster object, status and other metadata are omitted": {
+			desired: map[string]interface{}{
+				"apiVersion": "v1",
+				"kind":       "Service",
+				"metadata": map[string]interface{}{
+					"name":      "foo",
+					"namespace": "bar",
+					"labels": map[string]interface{}{
+						"app": "foo",
+					},
+				},
+				"spec": map[string]interface{}{
+					"type": "ClusterIP",
+				},
+			},
+			cluster: map[string]interface{}{
+				"apiVersion": "v1",
+				"kind":       "Service",
+				"metadata": map[string]interface{}{
+					"name":            "foo",
+					"namespace":       "bar",
+					"resourceVersion": "2",
+					"labels": map[string]interface{}{
+						"app":   "foo",
+						"extra": "label",
+					},
+				},
+				"spec": map[string]interface{}{
+					"type":      "NodePort",
+					"clusterIP": "10.0.0.1",
+				},
+				"status": map[string]interface{}{
+					"loadBalancer": map[string]interface{}{},
+				},
+			},
+			expected: map[string]interface{}{
+				"apiVersion": "v1",
+				"kind":       "Service",
+				"metadata": map[string]interface{}{
+					"name":      "foo",
+					"namespace": "bar",
+					"labels": map[string]interface{}{
+						"app": "foo",
+					},
+				},
+				"spec": map[string]interface{}{
+					"type": "NodePort",
+				},
+			},
+		},
+		"Additional list elements are copied whole": {
+			desired: map[string]interface{}{
+				"metadata": map[string]interface{}{
+					"name": "foo",
+				},
+				"spec": map[string]interface{}{
+					"containers": []interface{}{
+						map[string]interface{}{
+							"name": "foo",
+						},
+					},
+				},
+			},
+			cluster: map[string]interface{}{
+				"metadata": map[string]interface{}{
+					"name": "foo",
+				},
+				"spec": map[string]interface{}{
+					"containers": []interface{}{
+						map[string]interface{}{
+							"name":            "foo",
+							"imagePullPolicy": "Always",
+						},
+						map[string]interface{}{
+							"name":            "sidecar",
+							"imagePullPolicy": "Always",
+						},
+					},
+				},
+			},
+			expected: map[string]interface{}{
+				"metadata": map[string]interface{}{
+					"name": "foo",
+				},
+				"spec": map[string]interface{}{
+					"containers": []interface{}{
+						map[string]interface{}{
+							"name": "foo",
+						},
+						map[string]interface{}{
+							"name":            "sidecar",
+							"imagePullPolicy": "Always",
+						},
+					},
+				},
+			},
+		},
+	}
+	for testName, tc := range testCases {
+		t.Run(testName, func(t *testing.T) {
+			desiredObj := &unstructured.Unstructured{Object: tc.desired}
+			clusterObj := &unstructured.Unstructured{Object: tc.cluster}
+			obj := ComparedFields(desiredObj, clusterObj)
+			if !reflect.DeepEqual(obj.Object, tc.expected) {
+				t.Fatalf("Expected %v, got %v", tc.expected, obj.Object)
+			}
+		})
+	}
+}
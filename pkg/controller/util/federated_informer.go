@@ -154,7 +154,7 @@ func NewFederatedInformer(
 	federatedInformer := &federatedInformerImpl{
 		targetInformerFactory: targetInformerFactory,
 		configFactory: func(cluster *fedv1b1.KubeFedCluster) (*restclient.Config, error) {
-			clusterConfig, err := BuildClusterConfig(cluster, client, config.KubeFedNamespace)
+			clusterConfig, err := BuildClusterConfig(cluster, client, config.KubeFedNamespace, config.ClusterRateLimit)
 			if err != nil {
 				return nil, err
 			}
@@ -224,7 +224,7 @@ func NewFederatedInformer(
 					klog.Errorf("Internal error: Cluster %v not updated. New cluster not of correct type.", cur)
 					return
 				}
-				if IsClusterReady(&oldCluster.Status) != IsClusterReady(&curCluster.Status) || !reflect.DeepEqual(oldCluster.Spec, curCluster.Spec) || !reflect.DeepEqual(oldCluster.ObjectMeta.Labels, curCluster.ObjectMeta.Labels) || !reflect.DeepEqual(oldCluster.ObjectMeta.Annotations, curCluster.ObjectMeta.Annotations) {
+				if IsClusterReady(&oldCluster.Status) != IsClusterReady(&curCluster.Status) || !reflect.DeepEqual(oldCluster.Spec, curCluster.Spec) || !reflect.DeepEqual(oldCluster.ObjectMeta.Labels, curCluster.ObjectMeta.Labels) || !reflect.DeepEqual(oldCluster.ObjectMeta.Annotations, curCluster.ObjectMeta.Annotations) || overrideTemplateDataChanged(oldCluster, curCluster) {
 					var data []interface{}
 					if clusterLifecycle.ClusterUnavailable != nil {
 						data = getClusterData(oldCluster.Name)
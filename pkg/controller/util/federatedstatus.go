@@ -25,7 +25,8 @@ type FederatedResource struct {
 	metav1.TypeMeta   `json:",inline"`
 	metav1.ObjectMeta `json:"metadata,omitempty"`
 
-	ClusterStatus []ResourceClusterStatus `json:"clusterStatus,omitempty"`
+	ClusterStatus    []ResourceClusterStatus `json:"clusterStatus,omitempty"`
+	AggregatedStatus map[string]interface{}  `json:"aggregatedStatus,omitempty"`
 }
 
 // ResourceClusterStatus defines the status of federated resource within a cluster
@@ -0,0 +1,191 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package util
+
+import (
+	"github.com/pkg/errors"
+
+	appsv1 "k8s.io/api/apps/v1"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// ResourceHealth is the health of a resource in a member cluster.
+type ResourceHealth string
+
+const (
+	ResourceHealthy     ResourceHealth = "Healthy"
+	ResourceProgressing ResourceHealth = "Progressing"
+	ResourceDegraded    ResourceHealth = "Degraded"
+)
+
+var healthFuncs = map[schema.GroupKind]func(*unstructured.Unstructured) (ResourceHealth, error){
+	{Group: "apps", Kind: "Deployment"}:  deploymentHealth,
+	{Group: "apps", Kind: "StatefulSet"}: statefulSetHealth,
+	{Group: "apps", Kind: "DaemonSet"}:   daemonSetHealth,
+	{Group: "batch", Kind: "Job"}:        jobHealth,
+	{Group: "", Kind: ServiceKind}:       serviceHealth,
+}
+
+// GetResourceHealth determines the health of the given resource in a
+// member cluster from its status. Resources of kinds without a health
+// rule are healthy once they exist.
+func GetResourceHealth(clusterObj *unstructured.Unstructured) (ResourceHealth, error) {
+	healthFunc, ok := healthFuncs[clusterObj.GroupVersionKind().GroupKind()]
+	if !ok {
+		return ResourceHealthy, nil
+	}
+	return healthFunc(clusterObj)
+}
+
+// deploymentHealth determines the health of a deployment. A
+// deployment is progressing until all of its replicas are updated,
+// ready and available. It is degraded if it exceeded its progress
+// deadline, or if it lost the minimum availability after its rollout
+// completed, e.g. due to pods crash looping.
+func deploymentHealth(clusterObj *unstructured.Unstructured) (ResourceHealth, error) {
+	deployment := &appsv1.Deployment{}
+	err := runtime.DefaultUnstructuredConverter.FromUnstructured(clusterObj.Object, deployment)
+	if err != nil {
+		return "", errors.Wrap(err, "failed to convert deployment")
+	}
+	rolloutComplete := false
+	available := true
+	for _, condition := range deployment.Status.Conditions {
+		switch condition.Type {
+		case appsv1.DeploymentProgressing:
+			if condition.Status == corev1.ConditionFalse && condition.Reason == "ProgressDeadlineExceeded" {
+				return ResourceDegraded, nil
+			}
+			rolloutComplete = condition.Status == corev1.ConditionTrue && condition.Reason == "NewReplicaSetAvailable"
+		case appsv1.DeploymentAvailable:
+			available = condition.Status != corev1.ConditionFalse
+		}
+	}
+	if deployment.Status.ObservedGeneration < deployment.Generation {
+		return ResourceProgressing, nil
+	}
+	replicas := int32(1)
+	if deployment.Spec.Replicas != nil {
+		replicas = *deployment.Spec.Replicas
+	}
+	status := deployment.Status
+	if status.UpdatedReplicas < replicas || status.Replicas > status.UpdatedReplicas {
+		return ResourceProgressing, nil
+	}
+	if status.ReadyReplicas < replicas || status.AvailableReplicas < replicas {
+		if rolloutComplete && !available {
+			return ResourceDegraded, nil
+		}
+		return ResourceProgressing, nil
+	}
+	return ResourceHealthy, nil
+}
+
+// statefulSetHealth determines the health of a stateful set, which is
+// progressing until the replicas not excluded by a partition are
+// updated and all replicas are ready.
+func statefulSetHealth(clusterObj *unstructured.Unstructured) (ResourceHealth, error) {
+	statefulSet := &appsv1.StatefulSet{}
+	err := runtime.DefaultUnstructuredConverter.FromUnstructured(clusterObj.Object, statefulSet)
+	if err != nil {
+		return "", errors.Wrap(err, "failed to convert stateful set")
+	}
+	if statefulSet.Status.ObservedGeneration < statefulSet.Generation {
+		return ResourceProgressing, nil
+	}
+	replicas := int32(1)
+	if statefulSet.Spec.Replicas != nil {
+		replicas = *statefulSet.Spec.Replicas
+	}
+	strategy := statefulSet.Spec.UpdateStrategy
+	if strategy.Type != appsv1.OnDeleteStatefulSetStrategyType {
+		partition := int32(0)
+		if strategy.RollingUpdate != nil && strategy.RollingUpdate.Partition != nil {
+			partition = *strategy.RollingUpdate.Partition
+		}
+		if statefulSet.Status.UpdatedReplicas < replicas-partition {
+			return ResourceProgressing, nil
+		}
+	}
+	if statefulSet.Status.ReadyReplicas < replicas {
+		return ResourceProgressing, nil
+	}
+	return ResourceHealthy, nil
+}
+
+// daemonSetHealth determines the health of a daemon set, which is
+// progressing until its pods are updated and available on all nodes
+// they are scheduled to.
+func daemonSetHealth(clusterObj *unstructured.Unstructured) (ResourceHealth, error) {
+	daemonSet := &appsv1.DaemonSet{}
+	err := runtime.DefaultUnstructuredConverter.FromUnstructured(clusterObj.Object, daemonSet)
+	if err != nil {
+		return "", errors.Wrap(err, "failed to convert daemon set")
+	}
+	if daemonSet.Status.ObservedGeneration < daemonSet.Generation {
+		return ResourceProgressing, nil
+	}
+	status := daemonSet.Status
+	if daemonSet.Spec.UpdateStrategy.Type != appsv1.OnDeleteDaemonSetStrategyType &&
+		status.UpdatedNumberScheduled < status.DesiredNumberScheduled {
+		return ResourceProgressing, nil
+	}
+	if status.NumberAvailable < status.DesiredNumberScheduled {
+		return ResourceProgressing, nil
+	}
+	return ResourceHealthy, nil
+}
+
+// jobHealth determines the health of a job, which is healthy once it
+// completed and degraded if it failed.
+func jobHealth(clusterObj *unstructured.Unstructured) (ResourceHealth, error) {
+	job := &batchv1.Job{}
+	err := runtime.DefaultUnstructuredConverter.FromUnstructured(clusterObj.Object, job)
+	if err != nil {
+		return "", errors.Wrap(err, "failed to convert job")
+	}
+	for _, condition := range job.Status.Conditions {
+		if condition.Status != corev1.ConditionTrue {
+			continue
+		}
+		switch condition.Type {
+		case batchv1.JobFailed:
+			return ResourceDegraded, nil
+		case batchv1.JobComplete:
+			return ResourceHealthy, nil
+		}
+	}
+	return ResourceProgressing, nil
+}
+
+// serviceHealth determines the health of a service. A service of type
+// LoadBalancer is progressing until its load balancer is provisioned.
+func serviceHealth(clusterObj *unstructured.Unstructured) (ResourceHealth, error) {
+	service := &corev1.Service{}
+	err := runtime.DefaultUnstructuredConverter.FromUnstructured(clusterObj.Object, service)
+	if err != nil {
+		return "", errors.Wrap(err, "failed to convert service")
+	}
+	if service.Spec.Type == corev1.ServiceTypeLoadBalancer && len(service.Status.LoadBalancer.Ingress) == 0 {
+		return ResourceProgressing, nil
+	}
+	return ResourceHealthy, nil
+}
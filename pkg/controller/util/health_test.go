@@ -0,0 +1,212 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package util
+
+import (
+	"testing"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func TestGetResourceHealth(t *testing.T) {
+	completeDeployment := deploymentObject(2, 2, 2, 1, 1, "")
+	err := unstructured.SetNestedSlice(completeDeployment, []interface{}{
+		map[string]interface{}{
+			"type":   "Progressing",
+			"status": "True",
+			"reason": "NewReplicaSetAvailable",
+		},
+		map[string]interface{}{
+			"type":   "Available",
+			"status": "False",
+			"reason": "MinimumReplicasUnavailable",
+		},
+	}, "status", "conditions")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	testCases := map[string]struct {
+		obj            map[string]interface{}
+		expectedHealth ResourceHealth
+	}{
+		"Resources without a health rule are healthy": {
+			obj: map[string]interface{}{
+				"apiVersion": "v1",
+				"kind":       "ConfigMap",
+			},
+			expectedHealth: ResourceHealthy,
+		},
+		"Deployment with all replicas updated, ready and available is healthy": {
+			obj:            deploymentObject(2, 2, 2, 2, 2, ""),
+			expectedHealth: ResourceHealthy,
+		},
+		"Deployment with replicas that are not available is progressing": {
+			obj:            deploymentObject(2, 2, 2, 2, 1, ""),
+			expectedHealth: ResourceProgressing,
+		},
+		"Deployment with old replicas is progressing": {
+			obj:            deploymentObject(2, 3, 2, 2, 2, ""),
+			expectedHealth: ResourceProgressing,
+		},
+		"Deployment that exceeded its progress deadline is degraded": {
+			obj:            deploymentObject(2, 2, 1, 1, 1, "ProgressDeadlineExceeded"),
+			expectedHealth: ResourceDegraded,
+		},
+		"Deployment that lost availability after its rollout is degraded": {
+			obj:            completeDeployment,
+			expectedHealth: ResourceDegraded,
+		},
+		"Stateful set with all replicas updated and ready is healthy": {
+			obj:            statefulSetObject(3, 0, 3, 3),
+			expectedHealth: ResourceHealthy,
+		},
+		"Stateful set with replicas that are not ready is progressing": {
+			obj:            statefulSetObject(3, 0, 3, 2),
+			expectedHealth: ResourceProgressing,
+		},
+		"Stateful set with replicas that are not updated is progressing": {
+			obj:            statefulSetObject(3, 0, 2, 3),
+			expectedHealth: ResourceProgressing,
+		},
+		"Stateful set with the replicas outside its partition updated is healthy": {
+			obj:            statefulSetObject(3, 1, 2, 3),
+			expectedHealth: ResourceHealthy,
+		},
+		"Daemon set available on all nodes is healthy": {
+			obj:            daemonSetObject(3, 3, 3),
+			expectedHealth: ResourceHealthy,
+		},
+		"Daemon set with pods that are not updated is progressing": {
+			obj:            daemonSetObject(3, 2, 3),
+			expectedHealth: ResourceProgressing,
+		},
+		"Daemon set with pods that are not available is progressing": {
+			obj:            daemonSetObject(3, 3, 2),
+			expectedHealth: ResourceProgressing,
+		},
+		"Running job is progressing": {
+			obj:            jobObject(""),
+			expectedHealth: ResourceProgressing,
+		},
+		"Complete job is healthy": {
+			obj:            jobObject("Complete"),
+			expectedHealth: ResourceHealthy,
+		},
+		"Failed job is degraded": {
+			obj:            jobObject("Failed"),
+			expectedHealth: ResourceDegraded,
+		},
+		"Service of type ClusterIP is healthy": {
+			obj:            serviceObject("ClusterIP", false),
+			expectedHealth: ResourceHealthy,
+		},
+		"Load balancer service without ingress is progressing": {
+			obj:            serviceObject("LoadBalancer", false),
+			expectedHealth: ResourceProgressing,
+		},
+		"Load balancer service with ingress is healthy": {
+			obj:            serviceObject("LoadBalancer", true),
+			expectedHealth: ResourceHealthy,
+		},
+	}
+	for testName, tc := range testCases {
+		t.Run(testName, func(t *testing.T) {
+			health, err := GetResourceHealth(&unstructured.Unstructured{Object: tc.obj})
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if health != tc.expectedHealth {
+				t.Fatalf("Expected health %q, got %q", tc.expectedHealth, health)
+			}
+		})
+	}
+}
+
+func statefulSetObject(replicas, partition, updated, ready int64) map[string]interface{} {
+	spec := map[string]interface{}{
+		"replicas": replicas,
+	}
+	if partition > 0 {
+		spec["updateStrategy"] = map[string]interface{}{
+			"type": "RollingUpdate",
+			"rollingUpdate": map[string]interface{}{
+				"partition": partition,
+			},
+		}
+	}
+	return map[string]interface{}{
+		"apiVersion": "apps/v1",
+		"kind":       "StatefulSet",
+		"spec":       spec,
+		"status": map[string]interface{}{
+			"replicas":        replicas,
+			"updatedReplicas": updated,
+			"readyReplicas":   ready,
+		},
+	}
+}
+
+func daemonSetObject(desired, updated, available int64) map[string]interface{} {
+	return map[string]interface{}{
+		"apiVersion": "apps/v1",
+		"kind":       "DaemonSet",
+		"status": map[string]interface{}{
+			"desiredNumberScheduled": desired,
+			"updatedNumberScheduled": updated,
+			"numberAvailable":        available,
+		},
+	}
+}
+
+func jobObject(conditionType string) map[string]interface{} {
+	status := map[string]interface{}{}
+	if conditionType != "" {
+		status["conditions"] = []interface{}{
+			map[string]interface{}{
+				"type":   conditionType,
+				"status": "True",
+			},
+		}
+	}
+	return map[string]interface{}{
+		"apiVersion": "batch/v1",
+		"kind":       "Job",
+		"status":     status,
+	}
+}
+
+func serviceObject(serviceType string, provisioned bool) map[string]interface{} {
+	loadBalancer := map[string]interface{}{}
+	if provisioned {
+		loadBalancer["ingress"] = []interface{}{
+			map[string]interface{}{
+				"ip": "10.0.0.1",
+			},
+		}
+	}
+	return map[string]interface{}{
+		"apiVersion": "v1",
+		"kind":       "Service",
+		"spec": map[string]interface{}{
+			"type": serviceType,
+		},
+		"status": map[string]interface{}{
+			"loadBalancer": loadBalancer,
+		},
+	}
+}
@@ -0,0 +1,140 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package util
+
+import (
+	"strings"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+// ImageOverride rewrites components of the images of the containers
+// of a managed resource. Components that are not specified are left
+// unchanged.
+type ImageOverride struct {
+	// Registry replaces the registry host of an image, e.g.
+	// mirror.example.com:5000.
+	Registry string `json:"registry,omitempty"`
+	// Repository replaces the path of an image within its registry,
+	// e.g. library/nginx.
+	Repository string `json:"repository,omitempty"`
+	// Tag replaces the tag or digest of an image.
+	Tag string `json:"tag,omitempty"`
+}
+
+// containerListFields are the fields of a pod spec that contain
+// containers.
+var containerListFields = []string{"containers", "initContainers"}
+
+// applyImageOverride rewrites the image of every container and init
+// container of the pod specs found in the spec of the given object.
+// Pod specs are found wherever they are nested (e.g. the pod template
+// of a deployment or the job template of a cron job) so that any type
+// embedding a pod template is supported.
+func applyImageOverride(obj *unstructured.Unstructured, image *ImageOverride) error {
+	spec, ok := obj.Object[SpecField]
+	if !ok {
+		return nil
+	}
+	rewriteContainerImages(spec, image)
+	return nil
+}
+
+func rewriteContainerImages(value interface{}, image *ImageOverride) {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		for _, field := range containerListFields {
+			containers, ok := v[field].([]interface{})
+			if !ok {
+				continue
+			}
+			for _, rawContainer := range containers {
+				container, ok := rawContainer.(map[string]interface{})
+				if !ok {
+					continue
+				}
+				if containerImage, ok := container["image"].(string); ok {
+					container["image"] = image.Rewrite(containerImage)
+				}
+			}
+		}
+		for _, elem := range v {
+			rewriteContainerImages(elem, image)
+		}
+	case []interface{}:
+		for _, elem := range v {
+			rewriteContainerImages(elem, image)
+		}
+	}
+}
+
+// Rewrite returns the given image reference with the components of
+// the override applied. A digest is replaced if a tag is specified.
+func (o *ImageOverride) Rewrite(image string) string {
+	registry, repository, tag, digest := splitImage(image)
+	if o.Registry != "" {
+		registry = o.Registry
+	}
+	if o.Repository != "" {
+		repository = o.Repository
+	}
+	if o.Tag != "" {
+		tag = o.Tag
+		digest = ""
+	}
+
+	var b strings.Builder
+	if registry != "" {
+		b.WriteString(registry)
+		b.WriteString("/")
+	}
+	b.WriteString(repository)
+	if tag != "" {
+		b.WriteString(":")
+		b.WriteString(tag)
+	}
+	if digest != "" {
+		b.WriteString("@")
+		b.WriteString(digest)
+	}
+	return b.String()
+}
+
+// splitImage splits an image reference into its registry, repository,
+// tag and digest. Following the convention of the docker client, the
+// first component of the path is only considered to be a registry if
+// it contains a '.' or ':' or is 'localhost'.
+func splitImage(image string) (registry, repository, tag, digest string) {
+	rest := image
+	if i := strings.Index(rest, "@"); i >= 0 {
+		digest = rest[i+1:]
+		rest = rest[:i]
+	}
+	if i := strings.LastIndex(rest, ":"); i > strings.LastIndex(rest, "/") {
+		tag = rest[i+1:]
+		rest = rest[:i]
+	}
+	if i := strings.Index(rest, "/"); i >= 0 {
+		first := rest[:i]
+		if strings.ContainsAny(first, ".:") || first == "localhost" {
+			registry = first
+			rest = rest[i+1:]
+		}
+	}
+	repository = rest
+	return registry, repository, tag, digest
+}
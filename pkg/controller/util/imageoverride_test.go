@@ -0,0 +1,114 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package util
+
+import (
+	"reflect"
+	"testing"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func TestImageOverrideRewrite(t *testing.T) {
+	testCases := map[string]struct {
+		image         string
+		override      ImageOverride
+		expectedImage string
+	}{
+		"registry is added": {
+			image:         "nginx:1.17",
+			override:      ImageOverride{Registry: "mirror.example.com"},
+			expectedImage: "mirror.example.com/nginx:1.17",
+		},
+		"registry is replaced": {
+			image:         "gcr.io/project/app:v1",
+			override:      ImageOverride{Registry: "localhost:5000"},
+			expectedImage: "localhost:5000/project/app:v1",
+		},
+		"first path component without a dot is not a registry": {
+			image:         "library/nginx",
+			override:      ImageOverride{Registry: "mirror.example.com"},
+			expectedImage: "mirror.example.com/library/nginx",
+		},
+		"repository is replaced": {
+			image:         "localhost:5000/app:v1",
+			override:      ImageOverride{Repository: "team/app"},
+			expectedImage: "localhost:5000/team/app:v1",
+		},
+		"tag is added": {
+			image:         "quay.io/app",
+			override:      ImageOverride{Tag: "v2"},
+			expectedImage: "quay.io/app:v2",
+		},
+		"tag replaces digest": {
+			image:         "quay.io/app:v1@sha256:abc",
+			override:      ImageOverride{Tag: "v2"},
+			expectedImage: "quay.io/app:v2",
+		},
+		"digest is retained": {
+			image:         "app@sha256:abc",
+			override:      ImageOverride{Registry: "mirror.example.com"},
+			expectedImage: "mirror.example.com/app@sha256:abc",
+		},
+	}
+
+	for testName, tc := range testCases {
+		t.Run(testName, func(t *testing.T) {
+			image := tc.override.Rewrite(tc.image)
+			if image != tc.expectedImage {
+				t.Fatalf("Expected image %q, got %q", tc.expectedImage, image)
+			}
+		})
+	}
+}
+
+func TestApplyImageOverride(t *testing.T) {
+	podSpec := func(images ...string) map[string]interface{} {
+		var containers []interface{}
+		for _, image := range images {
+			containers = append(containers, map[string]interface{}{"image": image})
+		}
+		return map[string]interface{}{
+			"containers":     containers,
+			"initContainers": []interface{}{map[string]interface{}{"image": "init:v1"}},
+		}
+	}
+	obj := &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			"kind": "CronJob",
+			"spec": map[string]interface{}{
+				"jobTemplate": map[string]interface{}{
+					"spec": map[string]interface{}{
+						"template": map[string]interface{}{
+							"spec": podSpec("app:v1", "sidecar:v1"),
+						},
+					},
+				},
+			},
+		},
+	}
+	err := ApplyPatches(obj, ClusterPatches{{Type: ImagePatchType, Image: &ImageOverride{Registry: "mirror.example.com"}}})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	expectedPodSpec := podSpec("mirror.example.com/app:v1", "mirror.example.com/sidecar:v1")
+	expectedPodSpec["initContainers"] = []interface{}{map[string]interface{}{"image": "mirror.example.com/init:v1"}}
+	actualPodSpec, _, _ := unstructured.NestedMap(obj.Object, "spec", "jobTemplate", "spec", "template", "spec")
+	if !reflect.DeepEqual(actualPodSpec, expectedPodSpec) {
+		t.Fatalf("Expected pod spec %v, got %v", expectedPodSpec, actualPodSpec)
+	}
+}
@@ -102,6 +102,30 @@ func ObjectMetaObjEquivalent(a, b metav1.Object) bool {
 	return true
 }
 
+// ObjectMetaObjContained checks if the name, namespace, labels and
+// annotations of the desired object are present in the cluster object.
+// Labels and annotations of the cluster object that are not present
+// in the desired object are ignored.
+func ObjectMetaObjContained(desired, cluster metav1.Object) bool {
+	if desired.GetName() != cluster.GetName() {
+		return false
+	}
+	if desired.GetNamespace() != cluster.GetNamespace() {
+		return false
+	}
+	return mapContained(desired.GetLabels(), cluster.GetLabels()) &&
+		mapContained(desired.GetAnnotations(), cluster.GetAnnotations())
+}
+
+func mapContained(desired, cluster map[string]string) bool {
+	for key, value := range desired {
+		if clusterValue, ok := cluster[key]; !ok || clusterValue != value {
+			return false
+		}
+	}
+	return true
+}
+
 // Checks if cluster-independent, user provided data in ObjectMeta and Spec in two given top
 // level api objects are equivalent.
 func ObjectMetaAndSpecEquivalent(a, b runtimeclient.Object) bool {
@@ -0,0 +1,105 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package util
+
+import (
+	"encoding/json"
+	"sort"
+
+	"github.com/pkg/errors"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	policyv1a1 "sigs.k8s.io/kubefed/pkg/apis/policy/v1alpha1"
+)
+
+const (
+	OverridePolicyKind        = "OverridePolicy"
+	ClusterOverridePolicyKind = "ClusterOverridePolicy"
+)
+
+// PolicyOverrides are the override items of an override policy that
+// applies to a federated resource.
+type PolicyOverrides struct {
+	Policy    PolicyReference       `json:"policy"`
+	Overrides []GenericOverrideItem `json:"overrides"`
+}
+
+// SelectOverridePolicies returns the overrides of the override
+// policies that select the given federated resource, in the order in
+// which they are applied. The overrides of ClusterOverridePolicies
+// are applied before those of OverridePolicies in the namespace of
+// the resource. Policies of the same scope are applied in order of
+// increasing priority, and where priority is equal in reverse order
+// of name, so that the policy that would be chosen as the single
+// applicable policy of its scope takes precedence.
+func SelectOverridePolicies(resource *unstructured.Unstructured, policies []*policyv1a1.OverridePolicy, clusterPolicies []*policyv1a1.ClusterOverridePolicy) ([]PolicyOverrides, error) {
+	if resource == nil {
+		return nil, nil
+	}
+
+	type candidate struct {
+		ref  PolicyReference
+		spec *policyv1a1.OverridePolicySpec
+	}
+	var clusterCandidates, candidates []candidate
+	for _, policy := range clusterPolicies {
+		if policySelectsResource(ClusterOverridePolicyKind, policy.Name, policy.Spec.ResourceSelectors, true, resource) {
+			ref := PolicyReference{Kind: ClusterOverridePolicyKind, Name: policy.Name}
+			clusterCandidates = append(clusterCandidates, candidate{ref, &policy.Spec})
+		}
+	}
+	for _, policy := range policies {
+		if policy.Namespace == resource.GetNamespace() && policySelectsResource(OverridePolicyKind, policy.Name, policy.Spec.ResourceSelectors, false, resource) {
+			ref := PolicyReference{Kind: OverridePolicyKind, Namespace: policy.Namespace, Name: policy.Name}
+			candidates = append(candidates, candidate{ref, &policy.Spec})
+		}
+	}
+
+	var policyOverrides []PolicyOverrides
+	for _, scopeCandidates := range [][]candidate{clusterCandidates, candidates} {
+		sort.Slice(scopeCandidates, func(i, j int) bool {
+			c1, c2 := scopeCandidates[i], scopeCandidates[j]
+			if c1.spec.Priority != c2.spec.Priority {
+				return c1.spec.Priority < c2.spec.Priority
+			}
+			return c1.ref.Name > c2.ref.Name
+		})
+		for _, c := range scopeCandidates {
+			overrides, err := overrideRulesToItems(c.spec.Overrides)
+			if err != nil {
+				return nil, errors.Wrapf(err, "Failed to convert overrides of %s %q", c.ref.Kind, c.ref.Name)
+			}
+			policyOverrides = append(policyOverrides, PolicyOverrides{Policy: c.ref, Overrides: overrides})
+		}
+	}
+	return policyOverrides, nil
+}
+
+// overrideRulesToItems converts the rules of an override policy to
+// the generic override items of a federated resource.
+func overrideRulesToItems(rules []policyv1a1.OverrideRule) ([]GenericOverrideItem, error) {
+	rulesJSON, err := json.Marshal(rules)
+	if err != nil {
+		return nil, err
+	}
+	var items []GenericOverrideItem
+	if err := json.Unmarshal(rulesJSON, &items); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
@@ -0,0 +1,189 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package util
+
+import (
+	"reflect"
+	"testing"
+
+	apiextv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	fedv1b1 "sigs.k8s.io/kubefed/pkg/apis/core/v1beta1"
+	policyv1a1 "sigs.k8s.io/kubefed/pkg/apis/policy/v1alpha1"
+)
+
+func TestSelectOverridePolicies(t *testing.T) {
+	newSpec := func(priority int32, selector policyv1a1.ResourceSelector, replicas string) policyv1a1.OverridePolicySpec {
+		return policyv1a1.OverridePolicySpec{
+			ResourceSelectors: []policyv1a1.ResourceSelector{selector},
+			Priority:          priority,
+			Overrides: []policyv1a1.OverrideRule{
+				{
+					ClusterName: "cluster1",
+					ClusterOverrides: []policyv1a1.ClusterOverride{
+						{Path: "/spec/replicas", Value: &apiextv1.JSON{Raw: []byte(replicas)}},
+					},
+				},
+			},
+		}
+	}
+	namespaced := func(namespace, name string, spec policyv1a1.OverridePolicySpec) *policyv1a1.OverridePolicy {
+		return &policyv1a1.OverridePolicy{
+			ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: name},
+			Spec:       spec,
+		}
+	}
+	clusterScoped := func(name string, spec policyv1a1.OverridePolicySpec) *policyv1a1.ClusterOverridePolicy {
+		return &policyv1a1.ClusterOverridePolicy{
+			ObjectMeta: metav1.ObjectMeta{Name: name},
+			Spec:       spec,
+		}
+	}
+	deployments := policyv1a1.ResourceSelector{Kind: "FederatedDeployment"}
+	otherNamespace := policyv1a1.ResourceSelector{Kind: "FederatedDeployment", Namespace: "other"}
+	unmatchedLabels := policyv1a1.ResourceSelector{
+		Kind:          "FederatedDeployment",
+		LabelSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"app": "other"}},
+	}
+	services := policyv1a1.ResourceSelector{Kind: "FederatedService"}
+
+	testCases := map[string]struct {
+		policies        []*policyv1a1.OverridePolicy
+		clusterPolicies []*policyv1a1.ClusterOverridePolicy
+		expectedNames   []string
+	}{
+		"no policies": {},
+		"unselected policies are ignored": {
+			policies: []*policyv1a1.OverridePolicy{
+				namespaced("other", "other-namespace", newSpec(0, deployments, "1")),
+				namespaced("ns", "other-labels", newSpec(0, unmatchedLabels, "1")),
+				namespaced("ns", "other-kind", newSpec(0, services, "1")),
+			},
+			clusterPolicies: []*policyv1a1.ClusterOverridePolicy{
+				clusterScoped("other-namespace", newSpec(0, otherNamespace, "1")),
+			},
+		},
+		"cluster policies are applied before namespaced policies": {
+			policies: []*policyv1a1.OverridePolicy{
+				namespaced("ns", "namespaced", newSpec(0, deployments, "1")),
+			},
+			clusterPolicies: []*policyv1a1.ClusterOverridePolicy{
+				clusterScoped("cluster", newSpec(10, deployments, "2")),
+			},
+			expectedNames: []string{"cluster", "namespaced"},
+		},
+		"policies are applied by increasing priority and reverse name": {
+			policies: []*policyv1a1.OverridePolicy{
+				namespaced("ns", "a", newSpec(0, deployments, "1")),
+				namespaced("ns", "b", newSpec(0, deployments, "2")),
+				namespaced("ns", "c", newSpec(-1, deployments, "3")),
+			},
+			expectedNames: []string{"c", "b", "a"},
+		},
+	}
+
+	resource := &unstructured.Unstructured{}
+	resource.SetKind("FederatedDeployment")
+	resource.SetNamespace("ns")
+	resource.SetName("foo")
+	resource.SetLabels(map[string]string{"app": "foo"})
+
+	for testName, tc := range testCases {
+		t.Run(testName, func(t *testing.T) {
+			policyOverrides, err := SelectOverridePolicies(resource, tc.policies, tc.clusterPolicies)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			var names []string
+			for _, policy := range policyOverrides {
+				names = append(names, policy.Policy.Name)
+			}
+			if !reflect.DeepEqual(names, tc.expectedNames) {
+				t.Fatalf("Expected policies %v, got %v", tc.expectedNames, names)
+			}
+		})
+	}
+}
+
+func TestResolveOverridesWithPolicies(t *testing.T) {
+	clusters := []*fedv1b1.KubeFedCluster{
+		{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:   "cluster1",
+				Labels: map[string]string{"region": "us"},
+			},
+		},
+	}
+	policy := &policyv1a1.OverridePolicy{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: "policy"},
+		Spec: policyv1a1.OverridePolicySpec{
+			ResourceSelectors: []policyv1a1.ResourceSelector{{Kind: "FederatedDeployment"}},
+			Overrides: []policyv1a1.OverrideRule{
+				{
+					ClusterName: "cluster1",
+					ClusterOverrides: []policyv1a1.ClusterOverride{
+						{Path: "/spec/replicas", Value: &apiextv1.JSON{Raw: []byte("1")}},
+						{Path: "/spec/paused", Value: &apiextv1.JSON{Raw: []byte("true")}},
+					},
+				},
+			},
+		},
+	}
+	resource := &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			"kind": "FederatedDeployment",
+			"metadata": map[string]interface{}{
+				"namespace": "ns",
+				"name":      "foo",
+			},
+			"spec": map[string]interface{}{
+				OverridesField: []interface{}{
+					map[string]interface{}{
+						ClusterSelectorField: map[string]interface{}{
+							MatchLabelsField: map[string]interface{}{"region": "us"},
+						},
+						ClusterOverridesField: []interface{}{
+							map[string]interface{}{"path": "/spec/replicas", "value": int64(2)},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	policyOverrides, err := SelectOverridePolicies(resource, []*policyv1a1.OverridePolicy{policy}, nil)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	patchesMap, err := ResolveOverrides(resource, clusters, policyOverrides...)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	// The overrides of the resource take precedence over those of the
+	// policy, even when targeting the cluster by label.
+	expectedMap := PatchesMap{
+		"cluster1": ClusterPatches{
+			{Type: JSONPatchType, Overrides: ClusterOverrides{{Path: "/spec/paused", Value: true}}},
+			{Type: JSONPatchType, Overrides: ClusterOverrides{{Path: "/spec/replicas", Value: float64(2)}}},
+		},
+	}
+	if !reflect.DeepEqual(patchesMap, expectedMap) {
+		t.Fatalf("Expected patches %v, got %v", expectedMap, patchesMap)
+	}
+}
@@ -18,24 +18,56 @@ package util
 
 import (
 	"encoding/json"
+	"fmt"
+	"strings"
 
 	jsonpatch "github.com/evanphx/json-patch"
 	"github.com/pkg/errors"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/strategicpatch"
+	kubescheme "k8s.io/client-go/kubernetes/scheme"
+
+	fedv1b1 "sigs.k8s.io/kubefed/pkg/apis/core/v1beta1"
 )
 
 type ClusterOverride struct {
 	Op    string      `json:"op,omitempty"`
 	Path  string      `json:"path"`
+	From  string      `json:"from,omitempty"`
 	Value interface{} `json:"value,omitempty"`
 }
 
+// OverridePatchType determines how the overrides of an override item
+// are applied.
+type OverridePatchType string
+
+const (
+	// The clusterOverrides of the item are applied as RFC 6902 JSON
+	// patch operations.
+	JSONPatchType OverridePatchType = "json"
+	// The patch of the item is applied as an RFC 7386 JSON merge
+	// patch.
+	MergePatchType OverridePatchType = "merge"
+	// The patch of the item is applied as a strategic merge patch
+	// using the merge keys of the target type.
+	StrategicMergePatchType OverridePatchType = "strategic"
+	// The image of the item rewrites the images of all containers.
+	ImagePatchType OverridePatchType = "image"
+)
+
+// GenericOverrideItem targets either a single cluster by name or the
+// clusters matching a label selector.
 type GenericOverrideItem struct {
-	ClusterName      string            `json:"clusterName"`
-	ClusterOverrides []ClusterOverride `json:"clusterOverrides,omitempty"`
+	ClusterName      string                `json:"clusterName,omitempty"`
+	ClusterSelector  *metav1.LabelSelector `json:"clusterSelector,omitempty"`
+	PatchType        OverridePatchType     `json:"patchType,omitempty"`
+	ClusterOverrides []ClusterOverride     `json:"clusterOverrides,omitempty"`
+	Patch            interface{}           `json:"patch,omitempty"`
+	Image            *ImageOverride        `json:"image,omitempty"`
 }
 
 type GenericOverrideSpec struct {
@@ -67,6 +99,10 @@ var invalidPaths = sets.NewString(
 	"/kind",
 )
 
+// validJSONPatchOps are the JSON patch operations supported by
+// overrides. An empty op is applied as a replace.
+var validJSONPatchOps = sets.NewString("", "add", "remove", "replace", "move", "copy", "test")
+
 // Slice of ClusterOverride
 type ClusterOverrides []ClusterOverride
 
@@ -87,13 +123,281 @@ func (m OverridesMap) ToUnstructuredSlice() []interface{} {
 	return overrides
 }
 
-// GetOverrides returns a map of overrides populated from the given
-// unstructured object.
+// ClusterPatch is a single patch to apply to the managed resource for
+// a cluster.
+type ClusterPatch struct {
+	Type OverridePatchType
+	// Overrides are the operations of a JSON patch.
+	Overrides ClusterOverrides
+	// Patch is the document of a merge or strategic merge patch.
+	Patch interface{}
+	// Image is the rewrite of container images.
+	Image *ImageOverride
+}
+
+// ClusterPatches are the patches for a cluster in the order they are
+// applied.
+type ClusterPatches []ClusterPatch
+
+// Mapping of clusterName to the patches for the cluster
+type PatchesMap map[string]ClusterPatches
+
+// overrideItem is a validated override item.
+type overrideItem struct {
+	clusterName string
+	selector    labels.Selector
+	patch       ClusterPatch
+}
+
+// GetOverrides returns a map of the JSON patch overrides that target
+// clusters by name populated from the given unstructured object.
+// Items that target clusters by label selector or that use another
+// patch type are validated but not included.
 func GetOverrides(rawObj *unstructured.Unstructured) (OverridesMap, error) {
+	items, err := getOverrideItems(rawObj)
+	if err != nil {
+		return nil, err
+	}
 	overridesMap := make(OverridesMap)
+	for _, item := range items {
+		if item.selector == nil && item.patch.Type == JSONPatchType {
+			overridesMap[item.clusterName] = item.patch.Overrides
+		}
+	}
+	return overridesMap, nil
+}
 
+// ResolveOverrides returns the patches that apply to each of the
+// given clusters. The items whose cluster selector matches the labels
+// of a cluster are applied first, in the order the items are
+// specified, followed by the items naming the cluster. The overrides
+// of the given policies are applied in the same way, in order, ahead
+// of those of the federated resource. Where more than one applicable
+// item overrides the same path with a JSON patch, only the override
+// with the highest precedence is retained. Patches for named clusters
+// that are not in the given list are also returned.
+func ResolveOverrides(rawObj *unstructured.Unstructured, clusters []*fedv1b1.KubeFedCluster, policies ...PolicyOverrides) (PatchesMap, error) {
+	var itemSets [][]overrideItem
+	for _, policy := range policies {
+		items, err := parseOverrideItems(policy.Overrides)
+		if err != nil {
+			return nil, errors.Wrapf(err, "Invalid overrides of %s %q", policy.Policy.Kind, policy.Policy.Name)
+		}
+		itemSets = append(itemSets, items)
+	}
+	items, err := getOverrideItems(rawObj)
+	if err != nil {
+		return nil, err
+	}
+	itemSets = append(itemSets, items)
+
+	clusterLabels := make(map[string]labels.Set)
+	for _, cluster := range clusters {
+		clusterLabels[cluster.Name] = labels.Set(cluster.Labels)
+	}
+	clusterNames := sets.StringKeySet(clusterLabels)
+	for _, items := range itemSets {
+		for _, item := range items {
+			if item.selector == nil {
+				clusterNames.Insert(item.clusterName)
+			}
+		}
+	}
+
+	patchesMap := make(PatchesMap)
+	for _, clusterName := range clusterNames.List() {
+		var patches []ClusterPatch
+		for _, items := range itemSets {
+			// Selector items only apply to known clusters
+			if labelSet, ok := clusterLabels[clusterName]; ok {
+				for _, item := range items {
+					if item.selector != nil && item.selector.Matches(labelSet) {
+						patches = append(patches, item.patch)
+					}
+				}
+			}
+			for _, item := range items {
+				if item.selector == nil && item.clusterName == clusterName {
+					patches = append(patches, item.patch)
+				}
+			}
+		}
+		if len(patches) > 0 {
+			patchesMap[clusterName] = mergePatches(patches)
+		}
+	}
+	return patchesMap, nil
+}
+
+// ValidateOverrides validates the overrides of the given federated
+// resource and checks that the patches resolved for each cluster
+// named by the overrides apply to the given object in order of
+// precedence. The labels of the given clusters determine the items
+// with a cluster selector that apply to a named cluster, and a named
+// cluster that is not in the list is treated as having no labels.
+// Overrides with templated values depend on the properties of the
+// cluster and are not applied.
+func ValidateOverrides(rawObj, obj *unstructured.Unstructured, clusters []*fedv1b1.KubeFedCluster) error {
+	items, err := getOverrideItems(rawObj)
+	if err != nil {
+		return err
+	}
+	namedClusters := sets.NewString()
+	for i, item := range items {
+		if err := validatePatch(item.patch); err != nil {
+			return errors.Wrapf(err, "overrides[%d] is invalid", i)
+		}
+		if item.selector == nil {
+			namedClusters.Insert(item.clusterName)
+		}
+	}
+
+	knownClusters := sets.NewString()
+	for _, cluster := range clusters {
+		knownClusters.Insert(cluster.Name)
+	}
+	for _, clusterName := range namedClusters.Difference(knownClusters).List() {
+		clusters = append(clusters, &fedv1b1.KubeFedCluster{
+			ObjectMeta: metav1.ObjectMeta{Name: clusterName},
+		})
+	}
+	patchesMap, err := ResolveOverrides(rawObj, clusters)
+	if err != nil {
+		return err
+	}
+	for _, clusterName := range namedClusters.List() {
+		if err := applyUntemplatedPatches(obj.DeepCopy(), patchesMap[clusterName]); err != nil {
+			return errors.Wrapf(err, "overrides for cluster %q do not apply to the template", clusterName)
+		}
+	}
+	return nil
+}
+
+// applyUntemplatedPatches applies the given patches in order to the
+// given object, skipping JSON patch operations and patches with
+// templated values. JSON patch operations are applied one at a time
+// so that a failure identifies the path of the operation.
+func applyUntemplatedPatches(obj *unstructured.Unstructured, patches ClusterPatches) error {
+	for _, patch := range patches {
+		if patch.Type != JSONPatchType {
+			if hasTemplates(patch.Patch) || patch.Image != nil && hasTemplates(patch.Image.Registry, patch.Image.Repository, patch.Image.Tag) {
+				continue
+			}
+			if err := ApplyPatches(obj, ClusterPatches{patch}); err != nil {
+				return err
+			}
+			continue
+		}
+		for _, override := range patch.Overrides {
+			if hasTemplates(override.Value) {
+				continue
+			}
+			if err := ApplyJSONPatch(obj, ClusterOverrides{override}); err != nil {
+				return errors.Wrapf(err, "failed to apply %s override for path %q", opOrDefault(override.Op), override.Path)
+			}
+		}
+	}
+	return nil
+}
+
+// opOrDefault returns the given JSON patch operation, or replace if
+// the operation is not specified.
+func opOrDefault(op string) string {
+	if op == "" {
+		return "replace"
+	}
+	return op
+}
+
+// validatePatch checks that the operations of a JSON patch are
+// supported, that their paths are absolute and that the templated values of the patch parse.
+func validatePatch(patch ClusterPatch) error {
+	for _, override := range patch.Overrides {
+		if !validJSONPatchOps.Has(override.Op) {
+			return errors.Errorf("unsupported op %q for path %q", override.Op, override.Path)
+		}
+		if !strings.HasPrefix(override.Path, "/") {
+			return errors.Errorf("path %q must start with /", override.Path)
+		}
+		switch override.Op {
+		case "move", "copy":
+			if !strings.HasPrefix(override.From, "/") {
+				return errors.Errorf("from %q for path %q must start with /", override.From, override.Path)
+			}
+		default:
+			if override.From != "" {
+				return errors.Errorf("from may not be specified for op %q for path %q", opOrDefault(override.Op), override.Path)
+			}
+		}
+		if err := parseTemplates(override.Value); err != nil {
+			return errors.Wrapf(err, "invalid value for path %q", override.Path)
+		}
+	}
+	if patch.Patch != nil {
+		if err := parseTemplates(patch.Patch); err != nil {
+			return errors.Wrapf(err, "invalid %s patch", patch.Type)
+		}
+	}
+	if patch.Image != nil {
+		for _, value := range []string{patch.Image.Registry, patch.Image.Repository, patch.Image.Tag} {
+			if err := parseTemplates(value); err != nil {
+				return errors.Wrap(err, "invalid image override")
+			}
+		}
+	}
+	return nil
+}
+
+// mergePatches returns the given patches, ordered by increasing
+// precedence, with JSON patch operations removed where a patch of
+// higher precedence overrides the same path. Operations that do not
+// overwrite the value at their path are always retained. JSON patches
+// left without operations are omitted.
+func mergePatches(patches []ClusterPatch) ClusterPatches {
+	overriddenPaths := sets.NewString()
+	merged := ClusterPatches{}
+	for i := len(patches) - 1; i >= 0; i-- {
+		patch := patches[i]
+		if patch.Type == JSONPatchType {
+			overrides := ClusterOverrides{}
+			for _, override := range patch.Overrides {
+				if !overwritesPath(override) || !overriddenPaths.Has(override.Path) {
+					overrides = append(overrides, override)
+				}
+			}
+			if len(overrides) == 0 {
+				continue
+			}
+			for _, override := range overrides {
+				if overwritesPath(override) {
+					overriddenPaths.Insert(override.Path)
+				}
+			}
+			patch.Overrides = overrides
+		}
+		merged = append(ClusterPatches{patch}, merged...)
+	}
+	return merged
+}
+
+// overwritesPath returns whether the given JSON patch operation
+// overwrites the value at its path. A test does not modify its path,
+// and an add to the end of a list appends an element rather than
+// replacing one.
+func overwritesPath(override ClusterOverride) bool {
+	switch {
+	case override.Op == "test":
+		return false
+	case override.Op == "add" && strings.HasSuffix(override.Path, "/-"):
+		return false
+	default:
+		return true
+	}
+}
+
+func getOverrideItems(rawObj *unstructured.Unstructured) ([]overrideItem, error) {
 	if rawObj == nil {
-		return overridesMap, nil
+		return nil, nil
 	}
 
 	genericFedObject := GenericOverride{}
@@ -104,36 +408,134 @@ func GetOverrides(rawObj *unstructured.Unstructured) (OverridesMap, error) {
 
 	if genericFedObject.Spec == nil || genericFedObject.Spec.Overrides == nil {
 		// No overrides defined for the federated type
-		return overridesMap, nil
+		return nil, nil
 	}
 
-	for _, overrideItem := range genericFedObject.Spec.Overrides {
-		clusterName := overrideItem.ClusterName
-		if _, ok := overridesMap[clusterName]; ok {
-			return nil, errors.Errorf("cluster %q appears more than once", clusterName)
+	return parseOverrideItems(genericFedObject.Spec.Overrides)
+}
+
+// parseOverrideItems validates the given override items.
+func parseOverrideItems(genericItems []GenericOverrideItem) ([]overrideItem, error) {
+	var items []overrideItem
+	// Tracks the patch types used by items for each named cluster
+	namedPatchTypes := make(map[string]sets.String)
+	for itemIndex, genericItem := range genericItems {
+		clusterName := genericItem.ClusterName
+		hasSelector := genericItem.ClusterSelector != nil
+		if (len(clusterName) > 0) == hasSelector {
+			return nil, errors.Errorf("overrides[%d] must specify exactly one of clusterName or clusterSelector", itemIndex)
+		}
+
+		patchType := genericItem.PatchType
+		if patchType == "" {
+			patchType = JSONPatchType
+		}
+
+		// Describes the target of the item in errors
+		target := fmt.Sprintf("cluster %q", clusterName)
+		if hasSelector {
+			target = fmt.Sprintf("the cluster selector of overrides[%d]", itemIndex)
+		} else {
+			patchTypes, ok := namedPatchTypes[clusterName]
+			if !ok {
+				patchTypes = sets.NewString()
+				namedPatchTypes[clusterName] = patchTypes
+			}
+			if patchTypes.Has(string(patchType)) {
+				return nil, errors.Errorf("cluster %q appears more than once", clusterName)
+			}
+			patchTypes.Insert(string(patchType))
+		}
+
+		patch, err := newClusterPatch(patchType, &genericItem, target)
+		if err != nil {
+			return nil, err
+		}
+		item := overrideItem{
+			clusterName: clusterName,
+			patch:       *patch,
 		}
+		if hasSelector {
+			item.selector, err = metav1.LabelSelectorAsSelector(genericItem.ClusterSelector)
+			if err != nil {
+				return nil, errors.Wrapf(err, "invalid cluster selector for overrides[%d]", itemIndex)
+			}
+		}
+		items = append(items, item)
+	}
 
-		clusterOverrides := overrideItem.ClusterOverrides
+	return items, nil
+}
+
+// newClusterPatch validates the patch of the given override item.
+func newClusterPatch(patchType OverridePatchType, genericItem *GenericOverrideItem, target string) (*ClusterPatch, error) {
+	switch patchType {
+	case JSONPatchType, MergePatchType, StrategicMergePatchType, ImagePatchType:
+	default:
+		return nil, errors.Errorf("unsupported patch type %q for %s", patchType, target)
+	}
+	// Each patch type is specified by a single field of the item
+	isMergePatch := patchType == MergePatchType || patchType == StrategicMergePatchType
+	if patchType != JSONPatchType && len(genericItem.ClusterOverrides) > 0 {
+		return nil, errors.Errorf("clusterOverrides for %s may not be specified for patch type %q", target, patchType)
+	}
+	if !isMergePatch && genericItem.Patch != nil {
+		return nil, errors.Errorf("patch for %s may not be specified for patch type %q", target, patchType)
+	}
+	if patchType != ImagePatchType && genericItem.Image != nil {
+		return nil, errors.Errorf("image for %s may not be specified for patch type %q", target, patchType)
+	}
 
+	switch {
+	case patchType == JSONPatchType:
 		paths := sets.NewString()
-		for i, clusterOverride := range clusterOverrides {
+		for i, clusterOverride := range genericItem.ClusterOverrides {
 			path := clusterOverride.Path
 			if invalidPaths.Has(path) {
-				return nil, errors.Errorf("override[%d] for cluster %q has an invalid path: %s", i, clusterName, path)
+				return nil, errors.Errorf("override[%d] for %s has an invalid path: %s", i, target, path)
+			}
+			// Moving a field removes it from its original location
+			if clusterOverride.Op == "move" && invalidPaths.Has(clusterOverride.From) {
+				return nil, errors.Errorf("override[%d] for %s has an invalid from: %s", i, target, clusterOverride.From)
 			}
 			if paths.Has(path) {
-				return nil, errors.Errorf("path %q appears more than once for cluster %q", path, clusterName)
+				return nil, errors.Errorf("path %q appears more than once for %s", path, target)
 			}
 			paths.Insert(path)
 		}
-		overridesMap[clusterName] = clusterOverrides
+		return &ClusterPatch{Type: patchType, Overrides: genericItem.ClusterOverrides}, nil
+	case isMergePatch:
+		patch, ok := genericItem.Patch.(map[string]interface{})
+		if !ok {
+			return nil, errors.Errorf("patch for %s must be an object", target)
+		}
+		for path := range invalidPaths {
+			if _, ok := nestedPatchField(patch, path); ok {
+				return nil, errors.Errorf("patch for %s has an invalid path: %s", target, path)
+			}
+		}
+		return &ClusterPatch{Type: patchType, Patch: patch}, nil
+	default:
+		image := genericItem.Image
+		if image == nil || *image == (ImageOverride{}) {
+			return nil, errors.Errorf("image for %s must specify at least one of registry, repository or tag", target)
+		}
+		return &ClusterPatch{Type: patchType, Image: image}, nil
 	}
+}
 
-	return overridesMap, nil
+// nestedPatchField retrieves the field at the given JSON pointer path
+// of a merge patch.
+func nestedPatchField(patch map[string]interface{}, path string) (interface{}, bool) {
+	fields := strings.Split(strings.TrimPrefix(path, "/"), "/")
+	value, ok, _ := unstructured.NestedFieldNoCopy(patch, fields...)
+	return value, ok
 }
 
 // SetOverrides sets the spec.overrides field of the unstructured
-// object from the provided overrides map.
+// object from the provided overrides map. Existing items that target
+// clusters by label selector or that use a patch type other than
+// json are retained ahead of the items for named clusters.
 func SetOverrides(fedObject *unstructured.Unstructured, overridesMap OverridesMap) error {
 	rawSpec := fedObject.Object[SpecField]
 	if rawSpec == nil {
@@ -145,7 +547,20 @@ func SetOverrides(fedObject *unstructured.Unstructured, overridesMap OverridesMa
 	if !ok {
 		return errors.Errorf("Unable to set overrides since %q is not an object: %T", SpecField, rawSpec)
 	}
-	spec[OverridesField] = overridesMap.ToUnstructuredSlice()
+	overrides := []interface{}{}
+	if existing, ok := spec[OverridesField].([]interface{}); ok {
+		for _, rawItem := range existing {
+			item, ok := rawItem.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			patchType, _ := item[PatchTypeField].(string)
+			if item[ClusterSelectorField] != nil || patchType != "" && patchType != string(JSONPatchType) {
+				overrides = append(overrides, item)
+			}
+		}
+	}
+	spec[OverridesField] = append(overrides, overridesMap.ToUnstructuredSlice()...)
 	return nil
 }
 
@@ -159,6 +574,65 @@ func UnstructuredToInterface(rawObj *unstructured.Unstructured, obj interface{})
 	return json.Unmarshal(content, obj)
 }
 
+// ApplyPatches applies the given patches in order to the given
+// unstructured object.
+func ApplyPatches(obj *unstructured.Unstructured, patches ClusterPatches) error {
+	for _, patch := range patches {
+		var err error
+		switch patch.Type {
+		case JSONPatchType:
+			err = ApplyJSONPatch(obj, patch.Overrides)
+		case MergePatchType:
+			err = applyMergePatch(obj, patch.Patch, false)
+		case StrategicMergePatchType:
+			err = applyMergePatch(obj, patch.Patch, true)
+		case ImagePatchType:
+			err = applyImageOverride(obj, patch.Image)
+		default:
+			err = errors.Errorf("unsupported patch type %q", patch.Type)
+		}
+		if err != nil {
+			return errors.Wrapf(err, "failed to apply %s patch", patch.Type)
+		}
+	}
+	return nil
+}
+
+// applyMergePatch applies a JSON merge patch or, if strategic is
+// true, a strategic merge patch to the given unstructured object.  A
+// strategic merge patch requires the type of the object to be known
+// in order to determine the merge keys of its lists.
+func applyMergePatch(obj *unstructured.Unstructured, patch interface{}, strategic bool) error {
+	patchBytes, err := json.Marshal(patch)
+	if err != nil {
+		return err
+	}
+	objectJSONBytes, err := obj.MarshalJSON()
+	if err != nil {
+		return err
+	}
+
+	var patchedObjectJSONBytes []byte
+	if strategic {
+		gvk := obj.GroupVersionKind()
+		dataStruct, err := kubescheme.Scheme.New(gvk)
+		if err != nil {
+			return errors.Errorf("strategic merge patch is not supported for %s, use a merge patch instead", gvk)
+		}
+		patchedObjectJSONBytes, err = strategicpatch.StrategicMergePatch(objectJSONBytes, patchBytes, dataStruct)
+		if err != nil {
+			return err
+		}
+	} else {
+		patchedObjectJSONBytes, err = jsonpatch.MergePatch(objectJSONBytes, patchBytes)
+		if err != nil {
+			return err
+		}
+	}
+
+	return obj.UnmarshalJSON(patchedObjectJSONBytes)
+}
+
 // ApplyJSONPatch applies the override on to the given unstructured object.
 func ApplyJSONPatch(obj *unstructured.Unstructured, overrides ClusterOverrides) error {
 	// TODO: Do the defaulting of "op" field to "replace" in API defaulting
@@ -0,0 +1,658 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package util
+
+import (
+	"reflect"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	fedv1b1 "sigs.k8s.io/kubefed/pkg/apis/core/v1beta1"
+)
+
+func TestResolveOverrides(t *testing.T) {
+	clusters := []*fedv1b1.KubeFedCluster{
+		{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "cluster1",
+				Labels: map[string]string{
+					"region": "us",
+					"tier":   "prod",
+				},
+			},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "cluster2",
+				Labels: map[string]string{
+					"region": "eu",
+				},
+			},
+		},
+	}
+	selectorItem := func(labels map[string]interface{}, overrides ...interface{}) interface{} {
+		return map[string]interface{}{
+			ClusterSelectorField: map[string]interface{}{
+				MatchLabelsField: labels,
+			},
+			ClusterOverridesField: overrides,
+		}
+	}
+	namedItem := func(clusterName string, overrides ...interface{}) interface{} {
+		return map[string]interface{}{
+			ClusterNameField:      clusterName,
+			ClusterOverridesField: overrides,
+		}
+	}
+	override := func(path string, value interface{}) interface{} {
+		return map[string]interface{}{
+			"path":  path,
+			"value": value,
+		}
+	}
+	patchItem := func(clusterName string, patchType OverridePatchType, patch interface{}) interface{} {
+		return map[string]interface{}{
+			ClusterNameField: clusterName,
+			PatchTypeField:   string(patchType),
+			"patch":          patch,
+		}
+	}
+	jsonPatch := func(overrides ...ClusterOverride) ClusterPatch {
+		return ClusterPatch{Type: JSONPatchType, Overrides: overrides}
+	}
+
+	testCases := map[string]struct {
+		policies         []PolicyOverrides
+		overrides        []interface{}
+		expectedMap      PatchesMap
+		expectedErrorMsg string
+	}{
+		"named overrides only": {
+			overrides: []interface{}{
+				namedItem("cluster1", override("/spec/replicas", int64(1))),
+			},
+			expectedMap: PatchesMap{
+				"cluster1": ClusterPatches{jsonPatch(ClusterOverride{Path: "/spec/replicas", Value: float64(1)})},
+			},
+		},
+		"selector overrides apply to matching clusters": {
+			overrides: []interface{}{
+				selectorItem(map[string]interface{}{"region": "us"}, override("/spec/replicas", int64(2))),
+			},
+			expectedMap: PatchesMap{
+				"cluster1": ClusterPatches{jsonPatch(ClusterOverride{Path: "/spec/replicas", Value: float64(2)})},
+			},
+		},
+		"named overrides take precedence over selector overrides": {
+			overrides: []interface{}{
+				namedItem("cluster1", override("/spec/replicas", int64(1))),
+				selectorItem(map[string]interface{}{}, override("/spec/replicas", int64(2)), override("/spec/paused", true)),
+			},
+			expectedMap: PatchesMap{
+				"cluster1": ClusterPatches{
+					jsonPatch(ClusterOverride{Path: "/spec/paused", Value: true}),
+					jsonPatch(ClusterOverride{Path: "/spec/replicas", Value: float64(1)}),
+				},
+				"cluster2": ClusterPatches{
+					jsonPatch(
+						ClusterOverride{Path: "/spec/replicas", Value: float64(2)},
+						ClusterOverride{Path: "/spec/paused", Value: true},
+					),
+				},
+			},
+		},
+		"later selector overrides take precedence over earlier ones": {
+			overrides: []interface{}{
+				selectorItem(map[string]interface{}{"region": "us"}, override("/spec/replicas", int64(2))),
+				selectorItem(map[string]interface{}{"tier": "prod"}, override("/spec/replicas", int64(3))),
+			},
+			expectedMap: PatchesMap{
+				"cluster1": ClusterPatches{jsonPatch(ClusterOverride{Path: "/spec/replicas", Value: float64(3)})},
+			},
+		},
+		"test overrides do not take precedence": {
+			overrides: []interface{}{
+				selectorItem(map[string]interface{}{"region": "us"}, override("/spec/replicas", int64(2))),
+				namedItem("cluster1", map[string]interface{}{"op": "test", "path": "/spec/replicas", "value": int64(1)}),
+			},
+			expectedMap: PatchesMap{
+				"cluster1": ClusterPatches{
+					jsonPatch(ClusterOverride{Path: "/spec/replicas", Value: float64(2)}),
+					jsonPatch(ClusterOverride{Op: "test", Path: "/spec/replicas", Value: float64(1)}),
+				},
+			},
+		},
+		"appends to a list from different policies are not overridden": {
+			policies: []PolicyOverrides{
+				{
+					Policy: PolicyReference{Kind: ClusterOverridePolicyKind, Name: "cluster-policy"},
+					Overrides: []GenericOverrideItem{
+						{
+							ClusterSelector:  &metav1.LabelSelector{MatchLabels: map[string]string{"region": "us"}},
+							ClusterOverrides: []ClusterOverride{{Op: "add", Path: "/spec/args/-", Value: "-v"}},
+						},
+					},
+				},
+				{
+					Policy: PolicyReference{Kind: OverridePolicyKind, Namespace: "ns", Name: "policy"},
+					Overrides: []GenericOverrideItem{
+						{
+							ClusterName:      "cluster1",
+							ClusterOverrides: []ClusterOverride{{Op: "add", Path: "/spec/args/-", Value: "-q"}},
+						},
+					},
+				},
+			},
+			overrides: []interface{}{
+				namedItem("cluster1", override("/spec/args/0", "-x")),
+			},
+			expectedMap: PatchesMap{
+				"cluster1": ClusterPatches{
+					jsonPatch(ClusterOverride{Op: "add", Path: "/spec/args/-", Value: "-v"}),
+					jsonPatch(ClusterOverride{Op: "add", Path: "/spec/args/-", Value: "-q"}),
+					jsonPatch(ClusterOverride{Path: "/spec/args/0", Value: "-x"}),
+				},
+			},
+		},
+		"patch types are combined for a named cluster": {
+			overrides: []interface{}{
+				namedItem("cluster1", override("/spec/replicas", int64(1))),
+				patchItem("cluster1", MergePatchType, map[string]interface{}{"spec": map[string]interface{}{"paused": true}}),
+			},
+			expectedMap: PatchesMap{
+				"cluster1": ClusterPatches{
+					jsonPatch(ClusterOverride{Path: "/spec/replicas", Value: float64(1)}),
+					{Type: MergePatchType, Patch: map[string]interface{}{"spec": map[string]interface{}{"paused": true}}},
+				},
+			},
+		},
+		"merge patch with clusterOverrides is rejected": {
+			overrides: []interface{}{
+				map[string]interface{}{
+					ClusterNameField:      "cluster1",
+					PatchTypeField:        string(MergePatchType),
+					ClusterOverridesField: []interface{}{override("/spec/replicas", int64(1))},
+					"patch":               map[string]interface{}{},
+				},
+			},
+			expectedErrorMsg: `clusterOverrides for cluster "cluster1" may not be specified for patch type "merge"`,
+		},
+		"merge patch that is not an object is rejected": {
+			overrides: []interface{}{
+				patchItem("cluster1", StrategicMergePatchType, "foo"),
+			},
+			expectedErrorMsg: `patch for cluster "cluster1" must be an object`,
+		},
+		"merge patch of an invalid path is rejected": {
+			overrides: []interface{}{
+				patchItem("cluster1", MergePatchType, map[string]interface{}{"metadata": map[string]interface{}{"name": "foo"}}),
+			},
+			expectedErrorMsg: `patch for cluster "cluster1" has an invalid path: /metadata/name`,
+		},
+		"image patch without components is rejected": {
+			overrides: []interface{}{
+				map[string]interface{}{
+					ClusterNameField: "cluster1",
+					PatchTypeField:   string(ImagePatchType),
+					"image":          map[string]interface{}{},
+				},
+			},
+			expectedErrorMsg: `image for cluster "cluster1" must specify at least one of registry, repository or tag`,
+		},
+		"image with json patch type is rejected": {
+			overrides: []interface{}{
+				map[string]interface{}{
+					ClusterNameField: "cluster1",
+					"image":          map[string]interface{}{"tag": "v1"},
+				},
+			},
+			expectedErrorMsg: `image for cluster "cluster1" may not be specified for patch type "json"`,
+		},
+		"unsupported patch type is rejected": {
+			overrides: []interface{}{
+				patchItem("cluster1", "foo", map[string]interface{}{}),
+			},
+			expectedErrorMsg: `unsupported patch type "foo" for cluster "cluster1"`,
+		},
+		"duplicate cluster names are rejected": {
+			overrides: []interface{}{
+				namedItem("cluster1"),
+				namedItem("cluster1"),
+			},
+			expectedErrorMsg: `cluster "cluster1" appears more than once`,
+		},
+		"item without a target is rejected": {
+			overrides: []interface{}{
+				map[string]interface{}{},
+			},
+			expectedErrorMsg: "overrides[0] must specify exactly one of clusterName or clusterSelector",
+		},
+		"invalid path of selector item is rejected": {
+			overrides: []interface{}{
+				selectorItem(map[string]interface{}{}, override("/metadata/name", "foo")),
+			},
+			expectedErrorMsg: "override[0] for the cluster selector of overrides[0] has an invalid path: /metadata/name",
+		},
+	}
+
+	for testName, tc := range testCases {
+		t.Run(testName, func(t *testing.T) {
+			obj := &unstructured.Unstructured{
+				Object: map[string]interface{}{
+					"spec": map[string]interface{}{
+						OverridesField: tc.overrides,
+					},
+				},
+			}
+			overridesMap, err := ResolveOverrides(obj, clusters, tc.policies...)
+			if len(tc.expectedErrorMsg) > 0 {
+				if err == nil || err.Error() != tc.expectedErrorMsg {
+					t.Fatalf("Expected error %q, got %v", tc.expectedErrorMsg, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if !reflect.DeepEqual(overridesMap, tc.expectedMap) {
+				t.Fatalf("Expected overrides %v, got %v", tc.expectedMap, overridesMap)
+			}
+		})
+	}
+}
+
+func TestSetOverridesRetainsSelectorItems(t *testing.T) {
+	selectorItem := map[string]interface{}{
+		ClusterSelectorField: map[string]interface{}{},
+		ClusterOverridesField: []interface{}{
+			map[string]interface{}{"path": "/spec/paused", "value": true},
+		},
+	}
+	obj := &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			"spec": map[string]interface{}{
+				OverridesField: []interface{}{
+					selectorItem,
+					map[string]interface{}{ClusterNameField: "cluster1"},
+				},
+			},
+		},
+	}
+	err := SetOverrides(obj, OverridesMap{
+		"cluster2": ClusterOverrides{{Path: "/spec/replicas", Value: int64(1)}},
+	})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	overrides, _, _ := unstructured.NestedFieldNoCopy(obj.Object, SpecField, OverridesField)
+	items := overrides.([]interface{})
+	if len(items) != 2 || !reflect.DeepEqual(items[0], selectorItem) {
+		t.Fatalf("Expected the selector item to be retained ahead of the named item, got %v", items)
+	}
+	if items[1].(map[string]interface{})[ClusterNameField] != "cluster2" {
+		t.Fatalf("Expected an item for cluster2, got %v", items[1])
+	}
+}
+
+func TestApplyPatches(t *testing.T) {
+	newDeployment := func() *unstructured.Unstructured {
+		return &unstructured.Unstructured{
+			Object: map[string]interface{}{
+				"apiVersion": "apps/v1",
+				"kind":       "Deployment",
+				"spec": map[string]interface{}{
+					"replicas": int64(1),
+					"template": map[string]interface{}{
+						"spec": map[string]interface{}{
+							"containers": []interface{}{
+								map[string]interface{}{"name": "app", "image": "app:v1"},
+								map[string]interface{}{"name": "sidecar", "image": "sidecar:v1"},
+							},
+						},
+					},
+				},
+			},
+		}
+	}
+	containersPatch := map[string]interface{}{
+		"spec": map[string]interface{}{
+			"template": map[string]interface{}{
+				"spec": map[string]interface{}{
+					"containers": []interface{}{
+						map[string]interface{}{"name": "app", "image": "app:v2"},
+					},
+				},
+			},
+		},
+	}
+
+	testCases := map[string]struct {
+		obj                *unstructured.Unstructured
+		patches            ClusterPatches
+		expectedContainers []interface{}
+		expectedReplicas   int64
+		expectedErrorMsg   string
+	}{
+		"json patch": {
+			obj: newDeployment(),
+			patches: ClusterPatches{
+				{Type: JSONPatchType, Overrides: ClusterOverrides{{Path: "/spec/replicas", Value: int64(2)}}},
+			},
+			expectedContainers: []interface{}{
+				map[string]interface{}{"name": "app", "image": "app:v1"},
+				map[string]interface{}{"name": "sidecar", "image": "sidecar:v1"},
+			},
+			expectedReplicas: 2,
+		},
+		"merge patch replaces lists": {
+			obj:     newDeployment(),
+			patches: ClusterPatches{{Type: MergePatchType, Patch: containersPatch}},
+			expectedContainers: []interface{}{
+				map[string]interface{}{"name": "app", "image": "app:v2"},
+			},
+			expectedReplicas: 1,
+		},
+		"strategic merge patch merges lists by key": {
+			obj:     newDeployment(),
+			patches: ClusterPatches{{Type: StrategicMergePatchType, Patch: containersPatch}},
+			expectedContainers: []interface{}{
+				map[string]interface{}{"name": "app", "image": "app:v2"},
+				map[string]interface{}{"name": "sidecar", "image": "sidecar:v1"},
+			},
+			expectedReplicas: 1,
+		},
+		"strategic merge patch of an unknown type is rejected": {
+			obj: &unstructured.Unstructured{
+				Object: map[string]interface{}{
+					"apiVersion": "example.com/v1",
+					"kind":       "Foo",
+				},
+			},
+			patches:          ClusterPatches{{Type: StrategicMergePatchType, Patch: containersPatch}},
+			expectedErrorMsg: "failed to apply strategic patch: strategic merge patch is not supported for example.com/v1, Kind=Foo, use a merge patch instead",
+		},
+	}
+
+	for testName, tc := range testCases {
+		t.Run(testName, func(t *testing.T) {
+			err := ApplyPatches(tc.obj, tc.patches)
+			if len(tc.expectedErrorMsg) > 0 {
+				if err == nil || err.Error() != tc.expectedErrorMsg {
+					t.Fatalf("Expected error %q, got %v", tc.expectedErrorMsg, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			containers, _, _ := unstructured.NestedSlice(tc.obj.Object, "spec", "template", "spec", "containers")
+			if !reflect.DeepEqual(containers, tc.expectedContainers) {
+				t.Fatalf("Expected containers %v, got %v", tc.expectedContainers, containers)
+			}
+			replicas, _, _ := unstructured.NestedInt64(tc.obj.Object, "spec", "replicas")
+			if replicas != tc.expectedReplicas {
+				t.Fatalf("Expected %d replicas, got %d", tc.expectedReplicas, replicas)
+			}
+		})
+	}
+}
+
+func TestValidateOverrides(t *testing.T) {
+	testCases := map[string]struct {
+		overrides        []interface{}
+		clusterLabels    map[string]string
+		expectedErrorMsg string
+	}{
+		"valid overrides": {
+			overrides: []interface{}{
+				map[string]interface{}{
+					ClusterNameField: "cluster1",
+					ClusterOverridesField: []interface{}{
+						map[string]interface{}{"path": "/spec/replicas", "value": int64(2)},
+					},
+				},
+			},
+		},
+		"invalid path": {
+			overrides: []interface{}{
+				map[string]interface{}{
+					ClusterNameField: "cluster1",
+					ClusterOverridesField: []interface{}{
+						map[string]interface{}{"path": "/kind", "value": "foo"},
+					},
+				},
+			},
+			expectedErrorMsg: `override[0] for cluster "cluster1" has an invalid path: /kind`,
+		},
+		"patch that depends on a previous patch": {
+			overrides: []interface{}{
+				map[string]interface{}{
+					ClusterSelectorField: map[string]interface{}{},
+					ClusterOverridesField: []interface{}{
+						map[string]interface{}{"op": "add", "path": "/spec/foo", "value": map[string]interface{}{"bar": "a"}},
+					},
+				},
+				map[string]interface{}{
+					ClusterNameField: "cluster1",
+					ClusterOverridesField: []interface{}{
+						map[string]interface{}{"op": "replace", "path": "/spec/foo/bar", "value": "b"},
+					},
+				},
+			},
+		},
+		"templated value": {
+			overrides: []interface{}{
+				map[string]interface{}{
+					ClusterNameField: "cluster1",
+					ClusterOverridesField: []interface{}{
+						map[string]interface{}{"path": "/spec/replicas", "value": "{{ .Cluster.Labels.replicas }}"},
+					},
+				},
+			},
+		},
+		"template that does not parse": {
+			overrides: []interface{}{
+				map[string]interface{}{
+					ClusterNameField: "cluster1",
+					ClusterOverridesField: []interface{}{
+						map[string]interface{}{"path": "/spec/foo", "value": "{{ .Cluster.Name"},
+					},
+				},
+			},
+			expectedErrorMsg: `overrides[0] is invalid: invalid value for path "/spec/foo": template: override:1: unclosed action`,
+		},
+		"unsupported op": {
+			overrides: []interface{}{
+				map[string]interface{}{
+					ClusterNameField: "cluster1",
+					ClusterOverridesField: []interface{}{
+						map[string]interface{}{"op": "merge", "path": "/spec/foo"},
+					},
+				},
+			},
+			expectedErrorMsg: `overrides[0] is invalid: unsupported op "merge" for path "/spec/foo"`,
+		},
+		"move, copy and test": {
+			overrides: []interface{}{
+				map[string]interface{}{
+					ClusterNameField: "cluster1",
+					ClusterOverridesField: []interface{}{
+						map[string]interface{}{"op": "test", "path": "/spec/replicas", "value": int64(1)},
+						map[string]interface{}{"op": "copy", "from": "/spec/replicas", "path": "/spec/minReadySeconds"},
+						map[string]interface{}{"op": "move", "from": "/spec/paused", "path": "/spec/suspended"},
+					},
+				},
+			},
+		},
+		"move without from": {
+			overrides: []interface{}{
+				map[string]interface{}{
+					ClusterNameField: "cluster1",
+					ClusterOverridesField: []interface{}{
+						map[string]interface{}{"op": "move", "path": "/spec/foo"},
+					},
+				},
+			},
+			expectedErrorMsg: `overrides[0] is invalid: from "" for path "/spec/foo" must start with /`,
+		},
+		"move from an invalid path": {
+			overrides: []interface{}{
+				map[string]interface{}{
+					ClusterNameField: "cluster1",
+					ClusterOverridesField: []interface{}{
+						map[string]interface{}{"op": "move", "from": "/metadata/name", "path": "/spec/foo"},
+					},
+				},
+			},
+			expectedErrorMsg: `override[0] for cluster "cluster1" has an invalid from: /metadata/name`,
+		},
+		"from for an op other than move or copy": {
+			overrides: []interface{}{
+				map[string]interface{}{
+					ClusterNameField: "cluster1",
+					ClusterOverridesField: []interface{}{
+						map[string]interface{}{"path": "/spec/replicas", "from": "/spec/foo", "value": int64(2)},
+					},
+				},
+			},
+			expectedErrorMsg: `overrides[0] is invalid: from may not be specified for op "replace" for path "/spec/replicas"`,
+		},
+		"patch that does not apply": {
+			overrides: []interface{}{
+				map[string]interface{}{
+					ClusterNameField: "cluster1",
+					ClusterOverridesField: []interface{}{
+						map[string]interface{}{"path": "/spec/replicas", "value": int64(2)},
+						map[string]interface{}{"op": "replace", "path": "/spec/foo/bar", "value": "b"},
+					},
+				},
+			},
+			expectedErrorMsg: `overrides for cluster "cluster1" do not apply to the template: failed to apply replace override for path "/spec/foo/bar": replace operation does not apply: doc is missing path: /spec/foo/bar: missing value`,
+		},
+		"failing test": {
+			overrides: []interface{}{
+				map[string]interface{}{
+					ClusterNameField: "cluster1",
+					ClusterOverridesField: []interface{}{
+						map[string]interface{}{"op": "test", "path": "/spec/replicas", "value": int64(3)},
+					},
+				},
+			},
+			expectedErrorMsg: `overrides for cluster "cluster1" do not apply to the template: failed to apply test override for path "/spec/replicas": testing value /spec/replicas failed: test failed`,
+		},
+		"patch that depends on a patch for a matching cluster selector": {
+			overrides: []interface{}{
+				map[string]interface{}{
+					ClusterSelectorField: map[string]interface{}{
+						"matchLabels": map[string]interface{}{"tier": "gold"},
+					},
+					ClusterOverridesField: []interface{}{
+						map[string]interface{}{"op": "add", "path": "/spec/foo", "value": map[string]interface{}{"bar": "a"}},
+					},
+				},
+				map[string]interface{}{
+					ClusterNameField: "cluster1",
+					ClusterOverridesField: []interface{}{
+						map[string]interface{}{"op": "replace", "path": "/spec/foo/bar", "value": "b"},
+					},
+				},
+			},
+			clusterLabels: map[string]string{"tier": "gold"},
+		},
+		"patch that depends on a patch for a cluster selector that does not match": {
+			overrides: []interface{}{
+				map[string]interface{}{
+					ClusterSelectorField: map[string]interface{}{
+						"matchLabels": map[string]interface{}{"tier": "gold"},
+					},
+					ClusterOverridesField: []interface{}{
+						map[string]interface{}{"op": "add", "path": "/spec/foo", "value": map[string]interface{}{"bar": "a"}},
+					},
+				},
+				map[string]interface{}{
+					ClusterNameField: "cluster1",
+					ClusterOverridesField: []interface{}{
+						map[string]interface{}{"op": "replace", "path": "/spec/foo/bar", "value": "b"},
+					},
+				},
+			},
+			clusterLabels:    map[string]string{"tier": "silver"},
+			expectedErrorMsg: `overrides for cluster "cluster1" do not apply to the template: failed to apply replace override for path "/spec/foo/bar": replace operation does not apply: doc is missing path: /spec/foo/bar: missing value`,
+		},
+		"templated patch that would not apply": {
+			overrides: []interface{}{
+				map[string]interface{}{
+					ClusterNameField: "cluster1",
+					ClusterOverridesField: []interface{}{
+						map[string]interface{}{"path": "/spec/foo", "value": "{{ .Cluster.Name }}"},
+					},
+				},
+			},
+		},
+		"relative path": {
+			overrides: []interface{}{
+				map[string]interface{}{
+					ClusterNameField: "cluster1",
+					ClusterOverridesField: []interface{}{
+						map[string]interface{}{"path": "spec/foo", "value": "bar"},
+					},
+				},
+			},
+			expectedErrorMsg: `overrides[0] is invalid: path "spec/foo" must start with /`,
+		},
+	}
+
+	for testName, tc := range testCases {
+		t.Run(testName, func(t *testing.T) {
+			fedObj := &unstructured.Unstructured{
+				Object: map[string]interface{}{
+					"spec": map[string]interface{}{
+						OverridesField: tc.overrides,
+					},
+				},
+			}
+			obj := &unstructured.Unstructured{
+				Object: map[string]interface{}{
+					"apiVersion": "apps/v1",
+					"kind":       "Deployment",
+					"spec": map[string]interface{}{
+						"replicas": int64(1),
+						"paused":   true,
+					},
+				},
+			}
+			var clusters []*fedv1b1.KubeFedCluster
+			if tc.clusterLabels != nil {
+				clusters = append(clusters, &fedv1b1.KubeFedCluster{
+					ObjectMeta: metav1.ObjectMeta{Name: "cluster1", Labels: tc.clusterLabels},
+				})
+			}
+			err := ValidateOverrides(fedObj, obj, clusters)
+			if len(tc.expectedErrorMsg) > 0 {
+				if err == nil || err.Error() != tc.expectedErrorMsg {
+					t.Fatalf("Expected error %q, got %v", tc.expectedErrorMsg, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,227 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package util
+
+import (
+	"bytes"
+	"reflect"
+	"strings"
+	"text/template"
+
+	"github.com/pkg/errors"
+
+	fedv1b1 "sigs.k8s.io/kubefed/pkg/apis/core/v1beta1"
+)
+
+// OverrideTemplateData is the data available to templated override
+// values.
+type OverrideTemplateData struct {
+	Cluster ClusterTemplateData
+}
+
+// ClusterTemplateData describes the cluster an override is rendered
+// for.
+type ClusterTemplateData struct {
+	Name              string
+	Region            string
+	Zones             []string
+	Labels            map[string]string
+	KubernetesVersion string
+}
+
+// NewOverrideTemplateData returns the template data for the given
+// cluster. Only the name is populated if the cluster is not known.
+func NewOverrideTemplateData(clusterName string, cluster *fedv1b1.KubeFedCluster) *OverrideTemplateData {
+	data := &OverrideTemplateData{
+		Cluster: ClusterTemplateData{
+			Name:   clusterName,
+			Labels: map[string]string{},
+		},
+	}
+	if cluster == nil {
+		return data
+	}
+	if cluster.Labels != nil {
+		data.Cluster.Labels = cluster.Labels
+	}
+	if cluster.Status.Region != nil {
+		data.Cluster.Region = *cluster.Status.Region
+	}
+	data.Cluster.Zones = cluster.Status.Zones
+	data.Cluster.KubernetesVersion = cluster.Status.KubernetesVersion
+	return data
+}
+
+// overrideTemplateDataChanged indicates whether the properties of a
+// cluster exposed to override templates and not otherwise tracked
+// for changes differ between the given clusters.
+func overrideTemplateDataChanged(oldCluster, curCluster *fedv1b1.KubeFedCluster) bool {
+	oldData := NewOverrideTemplateData(oldCluster.Name, oldCluster).Cluster
+	curData := NewOverrideTemplateData(curCluster.Name, curCluster).Cluster
+	return oldData.Region != curData.Region ||
+		oldData.KubernetesVersion != curData.KubernetesVersion ||
+		!reflect.DeepEqual(oldData.Zones, curData.Zones)
+}
+
+// RenderPatches returns a copy of the given patches with string
+// values containing template actions rendered with the given data.
+// The patches are not modified.
+func RenderPatches(patches ClusterPatches, data *OverrideTemplateData) (ClusterPatches, error) {
+	rendered := make(ClusterPatches, 0, len(patches))
+	for _, patch := range patches {
+		if patch.Overrides != nil {
+			overrides := make(ClusterOverrides, 0, len(patch.Overrides))
+			for _, override := range patch.Overrides {
+				value, err := renderValue(override.Value, data)
+				if err != nil {
+					return nil, errors.Wrapf(err, "failed to render the value of the override for path %q", override.Path)
+				}
+				override.Value = value
+				overrides = append(overrides, override)
+			}
+			patch.Overrides = overrides
+		}
+		if patch.Patch != nil {
+			value, err := renderValue(patch.Patch, data)
+			if err != nil {
+				return nil, errors.Wrapf(err, "failed to render %s patch", patch.Type)
+			}
+			patch.Patch = value
+		}
+		if patch.Image != nil {
+			image, err := renderImageOverride(patch.Image, data)
+			if err != nil {
+				return nil, errors.Wrap(err, "failed to render image override")
+			}
+			patch.Image = image
+		}
+		rendered = append(rendered, patch)
+	}
+	return rendered, nil
+}
+
+func renderImageOverride(image *ImageOverride, data *OverrideTemplateData) (*ImageOverride, error) {
+	rendered := &ImageOverride{}
+	for _, field := range []struct {
+		value    string
+		rendered *string
+	}{
+		{image.Registry, &rendered.Registry},
+		{image.Repository, &rendered.Repository},
+		{image.Tag, &rendered.Tag},
+	} {
+		value, err := renderValue(field.value, data)
+		if err != nil {
+			return nil, err
+		}
+		*field.rendered = value.(string)
+	}
+	return rendered, nil
+}
+
+// hasTemplates returns whether any of the strings contained in the
+// given values contain template actions.
+func hasTemplates(values ...interface{}) bool {
+	for _, value := range values {
+		switch v := value.(type) {
+		case string:
+			if strings.Contains(v, "{{") {
+				return true
+			}
+		case map[string]interface{}:
+			for _, elem := range v {
+				if hasTemplates(elem) {
+					return true
+				}
+			}
+		case []interface{}:
+			if hasTemplates(v...) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// parseTemplates checks that the template actions of the strings
+// contained in the given value parse.
+func parseTemplates(value interface{}) error {
+	switch v := value.(type) {
+	case string:
+		if !strings.Contains(v, "{{") {
+			return nil
+		}
+		_, err := template.New("override").Parse(v)
+		return err
+	case map[string]interface{}:
+		for _, elem := range v {
+			if err := parseTemplates(elem); err != nil {
+				return err
+			}
+		}
+	case []interface{}:
+		for _, elem := range v {
+			if err := parseTemplates(elem); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// renderValue renders the template actions of the strings contained
+// in the given value. Maps and slices are copied rather than
+// modified.
+func renderValue(value interface{}, data *OverrideTemplateData) (interface{}, error) {
+	switch v := value.(type) {
+	case string:
+		if !strings.Contains(v, "{{") {
+			return v, nil
+		}
+		tmpl, err := template.New("override").Option("missingkey=error").Parse(v)
+		if err != nil {
+			return nil, err
+		}
+		var buf bytes.Buffer
+		if err := tmpl.Execute(&buf, data); err != nil {
+			return nil, err
+		}
+		return buf.String(), nil
+	case map[string]interface{}:
+		rendered := make(map[string]interface{}, len(v))
+		for key, elem := range v {
+			renderedElem, err := renderValue(elem, data)
+			if err != nil {
+				return nil, err
+			}
+			rendered[key] = renderedElem
+		}
+		return rendered, nil
+	case []interface{}:
+		rendered := make([]interface{}, 0, len(v))
+		for _, elem := range v {
+			renderedElem, err := renderValue(elem, data)
+			if err != nil {
+				return nil, err
+			}
+			rendered = append(rendered, renderedElem)
+		}
+		return rendered, nil
+	default:
+		return value, nil
+	}
+}
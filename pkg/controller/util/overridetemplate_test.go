@@ -0,0 +1,111 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package util
+
+import (
+	"reflect"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/pointer"
+
+	fedv1b1 "sigs.k8s.io/kubefed/pkg/apis/core/v1beta1"
+)
+
+func TestRenderPatches(t *testing.T) {
+	cluster := &fedv1b1.KubeFedCluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "cluster1",
+			Labels: map[string]string{
+				"tier": "prod",
+			},
+		},
+		Status: fedv1b1.KubeFedClusterStatus{
+			Region:            pointer.StringPtr("us-east1"),
+			KubernetesVersion: "v1.22.2",
+		},
+	}
+	data := NewOverrideTemplateData(cluster.Name, cluster)
+
+	testCases := map[string]struct {
+		patches          ClusterPatches
+		expectedPatches  ClusterPatches
+		expectedErrorMsg string
+	}{
+		"json patch values are rendered": {
+			patches: ClusterPatches{{
+				Type: JSONPatchType,
+				Overrides: ClusterOverrides{
+					{Path: "/spec/replicas", Value: float64(1)},
+					{Path: "/metadata/labels", Value: map[string]interface{}{
+						"cluster": "{{ .Cluster.Name }}",
+						"tier":    "{{ .Cluster.Labels.tier }}",
+					}},
+				},
+			}},
+			expectedPatches: ClusterPatches{{
+				Type: JSONPatchType,
+				Overrides: ClusterOverrides{
+					{Path: "/spec/replicas", Value: float64(1)},
+					{Path: "/metadata/labels", Value: map[string]interface{}{
+						"cluster": "cluster1",
+						"tier":    "prod",
+					}},
+				},
+			}},
+		},
+		"merge patch values are rendered": {
+			patches: ClusterPatches{{
+				Type: MergePatchType,
+				Patch: map[string]interface{}{
+					"args": []interface{}{"--region={{ .Cluster.Region }}", "--version={{ .Cluster.KubernetesVersion }}"},
+				},
+			}},
+			expectedPatches: ClusterPatches{{
+				Type: MergePatchType,
+				Patch: map[string]interface{}{
+					"args": []interface{}{"--region=us-east1", "--version=v1.22.2"},
+				},
+			}},
+		},
+		"missing label is rejected": {
+			patches: ClusterPatches{{
+				Type:      JSONPatchType,
+				Overrides: ClusterOverrides{{Path: "/spec/foo", Value: "{{ .Cluster.Labels.missing }}"}},
+			}},
+			expectedErrorMsg: `failed to render the value of the override for path "/spec/foo": template: override:1:11: executing "override" at <.Cluster.Labels.missing>: map has no entry for key "missing"`,
+		},
+	}
+
+	for testName, tc := range testCases {
+		t.Run(testName, func(t *testing.T) {
+			patches, err := RenderPatches(tc.patches, data)
+			if len(tc.expectedErrorMsg) > 0 {
+				if err == nil || err.Error() != tc.expectedErrorMsg {
+					t.Fatalf("Expected error %q, got %v", tc.expectedErrorMsg, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if !reflect.DeepEqual(patches, tc.expectedPatches) {
+				t.Fatalf("Expected patches %v, got %v", tc.expectedPatches, patches)
+			}
+		})
+	}
+}
@@ -0,0 +1,58 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package util
+
+import "k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+const (
+	// If this annotation is present on a federated resource, the sync
+	// controller does not create, update or delete resources in member
+	// clusters on behalf of the federated resource, but continues to
+	// collect their status.
+	PausePropagationAnnotation = "kubefed.io/paused"
+	PausedPropagationValue     = "true"
+)
+
+// IsPropagationPaused checks whether propagation of the given federated
+// resource to member clusters is paused.
+func IsPropagationPaused(obj *unstructured.Unstructured) bool {
+	annotations := obj.GetAnnotations()
+	if annotations == nil {
+		return false
+	}
+	return annotations[PausePropagationAnnotation] == PausedPropagationValue
+}
+
+// Pauses propagation to member clusters
+func PausePropagation(obj *unstructured.Unstructured) {
+	annotations := obj.GetAnnotations()
+	if annotations == nil {
+		annotations = make(map[string]string)
+	}
+	annotations[PausePropagationAnnotation] = PausedPropagationValue
+	obj.SetAnnotations(annotations)
+}
+
+// Resumes propagation to member clusters
+func ResumePropagation(obj *unstructured.Unstructured) {
+	annotations := obj.GetAnnotations()
+	if annotations == nil {
+		return
+	}
+	delete(annotations, PausePropagationAnnotation)
+	obj.SetAnnotations(annotations)
+}
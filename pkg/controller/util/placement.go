@@ -17,6 +17,13 @@ limitations under the License.
 package util
 
 import (
+	"hash/fnv"
+	"sort"
+	"time"
+
+	"github.com/pkg/errors"
+
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/labels"
@@ -29,20 +36,150 @@ type GenericClusterReference struct {
 	Name string `json:"name"`
 }
 
+// SpreadTopology identifies the attribute of a cluster's topology
+// that placement can be spread across.
+type SpreadTopology string
+
+const (
+	SpreadByRegion SpreadTopology = "Region"
+	SpreadByZone   SpreadTopology = "Zone"
+)
+
+// GenericSpreadConstraint limits selected clusters to a set that is
+// spread across distinct regions or zones.
+type GenericSpreadConstraint struct {
+	// SpreadBy determines whether clusters are grouped by the region
+	// or the zones reported in their status.
+	SpreadBy SpreadTopology `json:"spreadBy"`
+	// MaxGroups is the number of distinct regions or zones to select
+	// clusters from. All groups are used if zero.
+	MaxGroups int32 `json:"maxGroups,omitempty"`
+	// MaxClustersPerGroup is the number of clusters to select from
+	// each region or zone. All clusters in a group are used if zero.
+	MaxClustersPerGroup int32 `json:"maxClustersPerGroup,omitempty"`
+}
+
+// DefaultFailoverGracePeriod is the duration a selected cluster must
+// not be ready before it is replaced if a failover policy does not
+// specify a grace period.
+const DefaultFailoverGracePeriod = 5 * time.Minute
+
+// GenericFailover configures the temporary replacement of selected
+// clusters that are not ready.  A replacement is removed once the
+// cluster it replaces becomes ready again.
+type GenericFailover struct {
+	// GracePeriodSeconds is how long a selected cluster must not be
+	// ready before a replacement is chosen.
+	GracePeriodSeconds *int64 `json:"gracePeriodSeconds,omitempty"`
+	// BackupClusters is an ordered list of clusters to choose
+	// replacements from.
+	BackupClusters []GenericClusterReference `json:"backupClusters,omitempty"`
+	// AnyMatchingCluster indicates that replacements can be chosen
+	// from any other cluster matching the placement once the backup
+	// clusters, if any, have been exhausted.
+	AnyMatchingCluster bool `json:"anyMatchingCluster,omitempty"`
+}
+
 type GenericPlacementFields struct {
-	Clusters        []GenericClusterReference `json:"clusters,omitempty"`
-	ClusterSelector *metav1.LabelSelector     `json:"clusterSelector,omitempty"`
+	Clusters         []GenericClusterReference `json:"clusters,omitempty"`
+	ClusterSelector  *metav1.LabelSelector     `json:"clusterSelector,omitempty"`
+	SpreadConstraint *GenericSpreadConstraint  `json:"spreadConstraint,omitempty"`
+	Tolerations      []corev1.Toleration       `json:"tolerations,omitempty"`
+	Failover         *GenericFailover          `json:"failover,omitempty"`
+	MaxClusters      int32                     `json:"maxClusters,omitempty"`
 }
 
 type GenericPlacementSpec struct {
 	Placement GenericPlacementFields `json:"placement,omitempty"`
 }
 
+// GenericPlacementStatus exposes the clusters and placement
+// decisions recorded in the status of a federated resource to allow
+// placement to take into account the clusters the resource has
+// already been propagated to.
+type GenericPlacementStatus struct {
+	Clusters  []GenericClusterReference `json:"clusters,omitempty"`
+	Placement []GenericClusterDecision  `json:"placement,omitempty"`
+}
+
+// GenericClusterDecision is the placement decision recorded in the
+// status of a federated resource for a cluster.
+type GenericClusterDecision struct {
+	Name   string          `json:"name"`
+	Reason PlacementReason `json:"reason"`
+}
+
 type GenericPlacement struct {
 	metav1.TypeMeta   `json:",inline"`
 	metav1.ObjectMeta `json:"metadata,omitempty"`
 
-	Spec GenericPlacementSpec `json:"spec,omitempty"`
+	Spec   GenericPlacementSpec   `json:"spec,omitempty"`
+	Status GenericPlacementStatus `json:"status,omitempty"`
+}
+
+// PlacementReason explains the placement decision made for a cluster.
+type PlacementReason string
+
+const (
+	// The cluster was selected for placement.
+	PlacementSelected PlacementReason = "Selected"
+	// The cluster was not in the list of cluster names or did not
+	// match the cluster selector.
+	PlacementNotSelected PlacementReason = "NotSelectedByPlacement"
+	// The cluster was selected by the resource but not by the
+	// placement of its federated namespace.
+	PlacementExcludedByNamespace PlacementReason = "ExcludedByNamespacePlacement"
+	// The cluster has a taint that is not tolerated by the placement.
+	PlacementTaintNotTolerated PlacementReason = "TaintNotTolerated"
+	// The cluster was excluded by the spread constraint.
+	PlacementExcludedBySpreadConstraint PlacementReason = "ExcludedBySpreadConstraint"
+	// The cluster was not chosen due to the maximum number of
+	// clusters of the placement.
+	PlacementExcludedByMaxClusters PlacementReason = "ExcludedByMaxClusters"
+	// The cluster was selected for placement but is not ready.
+	PlacementClusterNotReady PlacementReason = "ClusterNotReady"
+	// The cluster was named in the placement but is not a member
+	// of the federation.
+	PlacementClusterUnknown PlacementReason = "ClusterUnknown"
+	// The cluster was selected to replace a selected cluster that
+	// has not been ready for longer than the failover grace period.
+	PlacementFailoverReplacement PlacementReason = "FailoverReplacement"
+	// The cluster is cordoned and the resource has not already been
+	// propagated to it.
+	PlacementClusterCordoned PlacementReason = "ClusterCordoned"
+	// The cluster is being drained of resources placed by cluster
+	// selector.
+	PlacementClusterDraining PlacementReason = "ClusterDraining"
+)
+
+// PlacementDecisions maps cluster names to the reason for the
+// placement decision made for the cluster.
+type PlacementDecisions map[string]PlacementReason
+
+// record sets the given reason for the named clusters.  It is a
+// no-op for nil decisions to allow placement to be computed without
+// recording decisions.
+func (d PlacementDecisions) record(clusterNames sets.String, reason PlacementReason) {
+	if d == nil {
+		return
+	}
+	for clusterName := range clusterNames {
+		d[clusterName] = reason
+	}
+}
+
+// recordSelected records the decision for the selected clusters,
+// distinguishing clusters that are not ready.
+func (d PlacementDecisions) recordSelected(selectedClusters sets.String, clusters []*fedv1b1.KubeFedCluster) {
+	if d == nil {
+		return
+	}
+	d.record(selectedClusters, PlacementSelected)
+	for _, cluster := range clusters {
+		if selectedClusters.Has(cluster.Name) && !IsClusterReady(&cluster.Status) {
+			d[cluster.Name] = PlacementClusterNotReady
+		}
+	}
 }
 
 func UnmarshalGenericPlacement(obj *unstructured.Unstructured) (*GenericPlacement, error) {
@@ -109,7 +246,28 @@ func SetClusterSelector(obj *unstructured.Unstructured, clusterSelector map[stri
 // clusters, so namespace placement becomes a mechanism for limiting
 // rather than allowing propagation.
 func ComputeNamespacedPlacement(resource, namespace *unstructured.Unstructured, clusters []*fedv1b1.KubeFedCluster, limitedScope bool, selectorOnly bool) (selectedClusters sets.String, err error) {
-	resourceClusters, err := ComputePlacement(resource, clusters, selectorOnly)
+	return computeNamespacedPlacement(resource, namespace, clusters, limitedScope, selectorOnly, nil)
+}
+
+// ComputeNamespacedPlacementDecisions determines placement for
+// namespaced federated resources in the same way as
+// ComputeNamespacedPlacement, and additionally returns the reason
+// for the placement decision made for each cluster.
+func ComputeNamespacedPlacementDecisions(resource, namespace *unstructured.Unstructured, clusters []*fedv1b1.KubeFedCluster, limitedScope bool, selectorOnly bool) (sets.String, PlacementDecisions, error) {
+	decisions := PlacementDecisions{}
+	selectedClusters, err := computeNamespacedPlacement(resource, namespace, clusters, limitedScope, selectorOnly, decisions)
+	if err != nil {
+		return nil, nil, err
+	}
+	return selectedClusters, decisions, nil
+}
+
+func computeNamespacedPlacement(resource, namespace *unstructured.Unstructured, clusters []*fedv1b1.KubeFedCluster, limitedScope bool, selectorOnly bool, decisions PlacementDecisions) (sets.String, error) {
+	placement, err := UnmarshalGenericPlacement(resource)
+	if err != nil {
+		return nil, err
+	}
+	resourceClusters, err := placement.candidateClusters(clusters, selectorOnly, decisions)
 	if err != nil {
 		return nil, err
 	}
@@ -119,9 +277,10 @@ func ComputeNamespacedPlacement(resource, namespace *unstructured.Unstructured,
 			// Use the resource placement verbatim if no federated
 			// namespace is present and KubeFed is targeting a
 			// single namespace.
-			return resourceClusters, nil
+			return placement.applyConstraints(resourceClusters, nil, clusters, decisions)
 		}
 		// Resource should not exist in any member clusters.
+		decisions.record(resourceClusters, PlacementExcludedByNamespace)
 		return sets.String{}, nil
 	}
 
@@ -131,19 +290,42 @@ func ComputeNamespacedPlacement(resource, namespace *unstructured.Unstructured,
 	}
 
 	// If both namespace and resource placement exist, the desired
-	// list of clusters is their intersection.
-	return resourceClusters.Intersection(namespaceClusters), nil
+	// list of clusters is their intersection.  Constraints of the
+	// resource placement are applied to the intersection so that
+	// they are not satisfied by clusters the namespace excludes.
+	decisions.record(resourceClusters.Difference(namespaceClusters), PlacementExcludedByNamespace)
+	return placement.applyConstraints(resourceClusters.Intersection(namespaceClusters), namespaceClusters, clusters, decisions)
 }
 
 // ComputePlacement determines the selected clusters for a federated
 // resource.
 func ComputePlacement(resource *unstructured.Unstructured, clusters []*fedv1b1.KubeFedCluster, selectorOnly bool) (selectedClusters sets.String, err error) {
-	selectedNames, err := selectedClusterNames(resource, clusters, selectorOnly)
+	return computePlacement(resource, clusters, selectorOnly, nil)
+}
+
+// ComputePlacementDecisions determines the selected clusters for a
+// federated resource in the same way as ComputePlacement, and
+// additionally returns the reason for the placement decision made
+// for each cluster.
+func ComputePlacementDecisions(resource *unstructured.Unstructured, clusters []*fedv1b1.KubeFedCluster, selectorOnly bool) (sets.String, PlacementDecisions, error) {
+	decisions := PlacementDecisions{}
+	selectedClusters, err := computePlacement(resource, clusters, selectorOnly, decisions)
+	if err != nil {
+		return nil, nil, err
+	}
+	return selectedClusters, decisions, nil
+}
+
+func computePlacement(resource *unstructured.Unstructured, clusters []*fedv1b1.KubeFedCluster, selectorOnly bool, decisions PlacementDecisions) (sets.String, error) {
+	placement, err := UnmarshalGenericPlacement(resource)
 	if err != nil {
 		return nil, err
 	}
-	clusterNames := getClusterNames(clusters)
-	return clusterNames.Intersection(selectedNames), nil
+	candidates, err := placement.candidateClusters(clusters, selectorOnly, decisions)
+	if err != nil {
+		return nil, err
+	}
+	return placement.applyConstraints(candidates, nil, clusters, decisions)
 }
 
 func selectedClusterNames(resource *unstructured.Unstructured, clusters []*fedv1b1.KubeFedCluster, selectorOnly bool) (sets.String, error) {
@@ -151,13 +333,53 @@ func selectedClusterNames(resource *unstructured.Unstructured, clusters []*fedv1
 	if err != nil {
 		return nil, err
 	}
+	return placement.selectedClusterNames(clusters, selectorOnly)
+}
+
+// candidateClusters returns the names of the given clusters that are
+// selected by the cluster names or selector of the placement and
+// whose taints are tolerated.
+func (p *GenericPlacement) candidateClusters(clusters []*fedv1b1.KubeFedCluster, selectorOnly bool, decisions PlacementDecisions) (sets.String, error) {
+	matchingNames, err := p.matchingClusterNames(clusters, selectorOnly)
+	if err != nil {
+		return nil, err
+	}
+	clusterNames := getClusterNames(clusters)
+	decisions.record(clusterNames.Difference(matchingNames), PlacementNotSelected)
+	decisions.record(matchingNames.Difference(clusterNames), PlacementClusterUnknown)
+
+	matchingClusters := clusterNames.Intersection(matchingNames)
+	tolerated := p.excludeTaintedClusters(matchingClusters, clusters)
+	decisions.record(matchingClusters.Difference(tolerated), PlacementTaintNotTolerated)
+	candidates := p.excludeUnschedulableClusters(tolerated, clusters, p.selectsByLabel(selectorOnly), decisions)
+	return candidates, nil
+}
+
+func (p *GenericPlacement) selectedClusterNames(clusters []*fedv1b1.KubeFedCluster, selectorOnly bool) (sets.String, error) {
+	matchingNames, err := p.matchingClusterNames(clusters, selectorOnly)
+	if err != nil {
+		return nil, err
+	}
+	tolerated := p.excludeTaintedClusters(matchingNames, clusters)
+	return p.excludeUnschedulableClusters(tolerated, clusters, p.selectsByLabel(selectorOnly), nil), nil
+}
+
+// selectsByLabel indicates whether clusters are chosen by the
+// cluster selector rather than by name.
+func (p *GenericPlacement) selectsByLabel(selectorOnly bool) bool {
+	return selectorOnly || p.ClusterNames() == nil
+}
 
+// matchingClusterNames returns the cluster names of the placement if
+// provided, otherwise the names of the clusters matching the cluster
+// selector of the placement.
+func (p *GenericPlacement) matchingClusterNames(clusters []*fedv1b1.KubeFedCluster, selectorOnly bool) (sets.String, error) {
 	selectedNames := sets.String{}
-	clusterNames := placement.ClusterNames()
+	clusterNames := p.ClusterNames()
 	// Only use selector if clusters are nil. An empty list of
 	// clusters implies no clusters are selected.
 	if selectorOnly || clusterNames == nil {
-		selector, err := placement.ClusterSelector()
+		selector, err := p.ClusterSelector()
 		if err != nil {
 			return nil, err
 		}
@@ -175,6 +397,395 @@ func selectedClusterNames(resource *unstructured.Unstructured, clusters []*fedv1
 	return selectedNames, nil
 }
 
+// excludeTaintedClusters removes clusters with taints that are not
+// tolerated by the placement.  A cluster with an untolerated
+// NoSchedule taint is only retained if the resource has already been
+// propagated to it, whereas a cluster with an untolerated NoExecute
+// taint is always removed so that existing resources will be deleted
+// from the cluster.  PreferNoSchedule taints are not enforced.
+func (p *GenericPlacement) excludeTaintedClusters(names sets.String, clusters []*fedv1b1.KubeFedCluster) sets.String {
+	selectedNames := sets.NewString(names.UnsortedList()...)
+	var propagatedNames sets.String
+	for _, cluster := range clusters {
+		if !selectedNames.Has(cluster.Name) {
+			continue
+		}
+		for i := range cluster.Spec.Taints {
+			taint := &cluster.Spec.Taints[i]
+			if taint.Effect == corev1.TaintEffectPreferNoSchedule || p.toleratesTaint(taint) {
+				continue
+			}
+			if taint.Effect == corev1.TaintEffectNoSchedule {
+				if propagatedNames == nil {
+					propagatedNames = p.propagatedClusterNames()
+				}
+				if propagatedNames.Has(cluster.Name) {
+					continue
+				}
+			}
+			selectedNames.Delete(cluster.Name)
+			break
+		}
+	}
+	return selectedNames
+}
+
+// excludeUnschedulableClusters removes cordoned and draining
+// clusters.  A cordoned cluster is only retained if the resource has
+// already been propagated to it.  A draining cluster is treated as
+// cordoned unless drainable is true (i.e. clusters were chosen by
+// label), in which case it is always removed so that existing
+// resources will be moved to other clusters.
+func (p *GenericPlacement) excludeUnschedulableClusters(names sets.String, clusters []*fedv1b1.KubeFedCluster, drainable bool, decisions PlacementDecisions) sets.String {
+	selectedNames := sets.NewString(names.UnsortedList()...)
+	var propagatedNames sets.String
+	for _, cluster := range clusters {
+		if !selectedNames.Has(cluster.Name) || !IsClusterUnschedulable(cluster) {
+			continue
+		}
+		if cluster.Spec.Drain && drainable {
+			selectedNames.Delete(cluster.Name)
+			decisions.record(sets.NewString(cluster.Name), PlacementClusterDraining)
+			continue
+		}
+		if propagatedNames == nil {
+			propagatedNames = p.propagatedClusterNames()
+		}
+		if !propagatedNames.Has(cluster.Name) {
+			selectedNames.Delete(cluster.Name)
+			decisions.record(sets.NewString(cluster.Name), PlacementClusterCordoned)
+		}
+	}
+	return selectedNames
+}
+
+// IsClusterUnschedulable indicates whether the cluster is cordoned or
+// being drained.
+func IsClusterUnschedulable(cluster *fedv1b1.KubeFedCluster) bool {
+	return cluster.Spec.Unschedulable || cluster.Spec.Drain
+}
+
+func (p *GenericPlacement) toleratesTaint(taint *corev1.Taint) bool {
+	for i := range p.Spec.Placement.Tolerations {
+		if p.Spec.Placement.Tolerations[i].ToleratesTaint(taint) {
+			return true
+		}
+	}
+	return false
+}
+
+// previouslySelectedClusterNames returns the names of the clusters
+// that were selected for placement when the resource was last
+// reconciled, excluding failover replacements.
+func (p *GenericPlacement) previouslySelectedClusterNames() sets.String {
+	clusterNames := sets.String{}
+	for _, placement := range p.Status.Placement {
+		if placement.Reason == PlacementSelected || placement.Reason == PlacementClusterNotReady {
+			clusterNames.Insert(placement.Name)
+		}
+	}
+	return clusterNames
+}
+
+// propagatedClusterNames returns the names of the clusters recorded
+// in the status of the resource.
+func (p *GenericPlacement) propagatedClusterNames() sets.String {
+	clusterNames := sets.String{}
+	for _, cluster := range p.Status.Clusters {
+		clusterNames.Insert(cluster.Name)
+	}
+	return clusterNames
+}
+
+// applyConstraints limits the candidate clusters according to the
+// constraints of the placement and adds failover replacements for
+// selected clusters that are not ready.  Permitted clusters limit the
+// clusters that can be used as replacements, and all clusters are
+// permitted if nil.
+func (p *GenericPlacement) applyConstraints(candidates, permitted sets.String, clusters []*fedv1b1.KubeFedCluster, decisions PlacementDecisions) (sets.String, error) {
+	spreadClusters, err := p.spreadClusters(candidates, clusters)
+	if err != nil {
+		return nil, err
+	}
+	decisions.record(candidates.Difference(spreadClusters), PlacementExcludedBySpreadConstraint)
+
+	selectedClusters, err := p.limitClusters(spreadClusters)
+	if err != nil {
+		return nil, err
+	}
+	decisions.record(spreadClusters.Difference(selectedClusters), PlacementExcludedByMaxClusters)
+	decisions.recordSelected(selectedClusters, clusters)
+
+	replacements, err := p.failoverClusters(selectedClusters, candidates, permitted, clusters, time.Now())
+	if err != nil {
+		return nil, err
+	}
+	decisions.record(replacements, PlacementFailoverReplacement)
+	return selectedClusters.Union(replacements), nil
+}
+
+// spreadClusters limits the candidate clusters to those satisfying
+// the spread constraint of the placement, if any.  Topology groups
+// and the clusters within them are ordered by a hash of their names
+// and the name of the resource. This ensures a stable selection
+// across reconciles without every resource favoring the same
+// clusters.
+func (p *GenericPlacement) spreadClusters(candidates sets.String, clusters []*fedv1b1.KubeFedCluster) (sets.String, error) {
+	constraint := p.Spec.Placement.SpreadConstraint
+	if constraint == nil {
+		return candidates, nil
+	}
+	if constraint.SpreadBy != SpreadByRegion && constraint.SpreadBy != SpreadByZone {
+		return nil, errors.Errorf("spread constraint has an invalid spreadBy value %q: must be one of %q or %q", constraint.SpreadBy, SpreadByRegion, SpreadByZone)
+	}
+	if constraint.MaxGroups < 0 || constraint.MaxClustersPerGroup < 0 {
+		return nil, errors.New("spread constraint limits may not be negative")
+	}
+
+	// Clusters that do not report the topology being spread across
+	// cannot satisfy the constraint and will not be selected.
+	groupClusters := make(map[string][]string)
+	for _, cluster := range clusters {
+		if !candidates.Has(cluster.Name) {
+			continue
+		}
+		for _, group := range topologyGroups(cluster, constraint.SpreadBy) {
+			groupClusters[group] = append(groupClusters[group], cluster.Name)
+		}
+	}
+
+	key := p.placementKey()
+	groups := make([]string, 0, len(groupClusters))
+	for group := range groupClusters {
+		groups = append(groups, group)
+	}
+	sortByHash(groups, key)
+
+	selected := sets.String{}
+	for i, group := range groups {
+		if constraint.MaxGroups > 0 && int32(i) >= constraint.MaxGroups {
+			break
+		}
+		names := groupClusters[group]
+		sortByHash(names, key)
+
+		// A cluster in multiple zones that was selected for a
+		// previous group also counts towards this group.
+		count := int32(0)
+		for _, name := range names {
+			if selected.Has(name) {
+				count++
+			}
+		}
+		for _, name := range names {
+			if constraint.MaxClustersPerGroup > 0 && count >= constraint.MaxClustersPerGroup {
+				break
+			}
+			if selected.Has(name) {
+				continue
+			}
+			selected.Insert(name)
+			count++
+		}
+	}
+	return selected, nil
+}
+
+// limitClusters limits the candidate clusters to the maximum number
+// of clusters of the placement, if any.  Candidates that were
+// previously selected are preferred so that the choice of clusters
+// only changes when a chosen cluster stops being a candidate.
+// Otherwise candidates are chosen in the order of a hash of their
+// names and the name of the resource.
+func (p *GenericPlacement) limitClusters(candidates sets.String) (sets.String, error) {
+	maxClusters := p.Spec.Placement.MaxClusters
+	if maxClusters < 0 {
+		return nil, errors.New("maxClusters may not be negative")
+	}
+	if maxClusters == 0 || int32(candidates.Len()) <= maxClusters {
+		return candidates, nil
+	}
+
+	previouslySelected := p.previouslySelectedClusterNames()
+	names := candidates.List()
+	sortByHash(names, p.placementKey())
+	sort.SliceStable(names, func(i, j int) bool {
+		return previouslySelected.Has(names[i]) && !previouslySelected.Has(names[j])
+	})
+	return sets.NewString(names[:maxClusters]...), nil
+}
+
+// failoverClusters returns the clusters that replace selected
+// clusters that have not been ready for longer than the grace period
+// of the failover policy as of the given time.  Replacements are
+// chosen from the ready backup clusters, in order, followed by the
+// ready candidate clusters if any matching cluster may be used.
+func (p *GenericPlacement) failoverClusters(selectedClusters, candidates, permitted sets.String, clusters []*fedv1b1.KubeFedCluster, now time.Time) (sets.String, error) {
+	replacements := sets.String{}
+	failover := p.Spec.Placement.Failover
+	if failover == nil {
+		return replacements, nil
+	}
+
+	failedNames, _, err := p.failedClusters(selectedClusters, clusters, now)
+	if err != nil || len(failedNames) == 0 {
+		return replacements, err
+	}
+
+	readyNames := sets.String{}
+	for _, cluster := range clusters {
+		if IsClusterReady(&cluster.Status) {
+			readyNames.Insert(cluster.Name)
+		}
+	}
+	eligible := func(name string) bool {
+		return readyNames.Has(name) && !selectedClusters.Has(name) && !replacements.Has(name) &&
+			(permitted == nil || permitted.Has(name))
+	}
+
+	var backupNames []string
+	if len(failover.BackupClusters) > 0 {
+		names := sets.String{}
+		for _, backup := range failover.BackupClusters {
+			names.Insert(backup.Name)
+		}
+		tolerated := p.excludeUnschedulableClusters(p.excludeTaintedClusters(names, clusters), clusters, true, nil)
+		for _, backup := range failover.BackupClusters {
+			if tolerated.Has(backup.Name) {
+				backupNames = append(backupNames, backup.Name)
+			}
+		}
+	}
+	if failover.AnyMatchingCluster {
+		names := candidates.List()
+		sortByHash(names, p.placementKey())
+		backupNames = append(backupNames, names...)
+	}
+
+	for range failedNames {
+		for _, name := range backupNames {
+			if eligible(name) {
+				replacements.Insert(name)
+				break
+			}
+		}
+	}
+	return replacements, nil
+}
+
+// failedClusters returns the names of the selected clusters that
+// have not been ready for longer than the failover grace period as
+// of the given time.  If one or more selected clusters are not ready
+// but within the grace period, the time remaining until the earliest
+// grace period elapses is also returned.
+func (p *GenericPlacement) failedClusters(selectedClusters sets.String, clusters []*fedv1b1.KubeFedCluster, now time.Time) ([]string, time.Duration, error) {
+	failover := p.Spec.Placement.Failover
+	gracePeriod := DefaultFailoverGracePeriod
+	if failover.GracePeriodSeconds != nil {
+		if *failover.GracePeriodSeconds < 0 {
+			return nil, 0, errors.New("failover grace period may not be negative")
+		}
+		gracePeriod = time.Duration(*failover.GracePeriodSeconds) * time.Second
+	}
+
+	var failedNames []string
+	var pending time.Duration
+	for _, cluster := range clusters {
+		if !selectedClusters.Has(cluster.Name) {
+			continue
+		}
+		notReadySince := clusterNotReadySince(cluster)
+		if notReadySince == nil {
+			continue
+		}
+		remaining := notReadySince.Add(gracePeriod).Sub(now)
+		if remaining <= 0 {
+			failedNames = append(failedNames, cluster.Name)
+		} else if pending == 0 || remaining < pending {
+			pending = remaining
+		}
+	}
+	sort.Strings(failedNames)
+	return failedNames, pending, nil
+}
+
+// clusterNotReadySince returns the time a cluster last transitioned
+// to not being ready, or nil if the cluster is ready or has yet to
+// report its health.
+func clusterNotReadySince(cluster *fedv1b1.KubeFedCluster) *metav1.Time {
+	if IsClusterReady(&cluster.Status) {
+		return nil
+	}
+	// The cluster controller sets the same transition time for all
+	// conditions.
+	for _, condition := range cluster.Status.Conditions {
+		if condition.LastTransitionTime != nil {
+			return condition.LastTransitionTime
+		}
+	}
+	return nil
+}
+
+// PendingFailoverDelay returns the time remaining until the failover
+// grace period elapses for the earliest of the selected clusters of
+// the given resource that is not ready.  False is returned if the
+// resource has no failover policy or no failover is pending.
+func PendingFailoverDelay(resource *unstructured.Unstructured, selectedClusters sets.String, clusters []*fedv1b1.KubeFedCluster) (time.Duration, bool, error) {
+	placement, err := UnmarshalGenericPlacement(resource)
+	if err != nil {
+		return 0, false, err
+	}
+	if placement.Spec.Placement.Failover == nil {
+		return 0, false, nil
+	}
+	_, pending, err := placement.failedClusters(selectedClusters, clusters, time.Now())
+	if err != nil {
+		return 0, false, err
+	}
+	return pending, pending > 0, nil
+}
+
+// placementKey returns the string used to salt the hashes of cluster
+// and topology group names for the placement's resource.
+func (p *GenericPlacement) placementKey() string {
+	return QualifiedName{Namespace: p.Namespace, Name: p.Name}.String()
+}
+
+func topologyGroups(cluster *fedv1b1.KubeFedCluster, spreadBy SpreadTopology) []string {
+	switch spreadBy {
+	case SpreadByRegion:
+		if cluster.Status.Region != nil && len(*cluster.Status.Region) > 0 {
+			return []string{*cluster.Status.Region}
+		}
+	case SpreadByZone:
+		return cluster.Status.Zones
+	}
+	return nil
+}
+
+// sortByHash sorts the given names by the hash of each name salted
+// with the given key, falling back to the name to break ties.
+func sortByHash(names []string, key string) {
+	hashes := make(map[string]uint32, len(names))
+	for _, name := range names {
+		hashes[name] = hashName(name, key)
+	}
+	sort.Slice(names, func(i, j int) bool {
+		if hashes[names[i]] != hashes[names[j]] {
+			return hashes[names[i]] < hashes[names[j]]
+		}
+		return names[i] < names[j]
+	})
+}
+
+func hashName(name, key string) uint32 {
+	hasher := fnv.New32()
+	// Writes to a hash never return an error.
+	_, _ = hasher.Write([]byte(name))
+	_, _ = hasher.Write([]byte(key))
+	return hasher.Sum32()
+}
+
 func getClusterNames(clusters []*fedv1b1.KubeFedCluster) sets.String {
 	clusterNames := sets.String{}
 	for _, cluster := range clusters {
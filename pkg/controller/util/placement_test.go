@@ -19,11 +19,14 @@ package util
 import (
 	"reflect"
 	"testing"
+	"time"
 
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/util/sets"
 
+	"sigs.k8s.io/kubefed/pkg/apis/core/common"
 	fedv1b1 "sigs.k8s.io/kubefed/pkg/apis/core/v1beta1"
 )
 
@@ -102,3 +105,704 @@ func TestSelectedClusterNames(t *testing.T) {
 		})
 	}
 }
+
+func TestSelectedClusterNamesWithTaints(t *testing.T) {
+	newCluster := func(name string, taints ...corev1.Taint) *fedv1b1.KubeFedCluster {
+		return &fedv1b1.KubeFedCluster{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: name,
+			},
+			Spec: fedv1b1.KubeFedClusterSpec{
+				Taints: taints,
+			},
+		}
+	}
+	clusters := []*fedv1b1.KubeFedCluster{
+		newCluster("cluster1"),
+		newCluster("cluster2", corev1.Taint{Key: "dedicated", Value: "gpu", Effect: corev1.TaintEffectNoSchedule}),
+		newCluster("cluster3", corev1.Taint{Key: "maintenance", Effect: corev1.TaintEffectNoExecute}),
+		newCluster("cluster4", corev1.Taint{Key: "spot", Effect: corev1.TaintEffectPreferNoSchedule}),
+	}
+
+	testCases := map[string]struct {
+		tolerations        []interface{}
+		propagatedClusters []string
+		expectedNames      sets.String
+	}{
+		"untolerated taints exclude clusters": {
+			expectedNames: sets.NewString("cluster1", "cluster4"),
+		},
+		"tolerated NoSchedule taint": {
+			tolerations: []interface{}{
+				map[string]interface{}{
+					"key":      "dedicated",
+					"operator": "Equal",
+					"value":    "gpu",
+					"effect":   "NoSchedule",
+				},
+			},
+			expectedNames: sets.NewString("cluster1", "cluster2", "cluster4"),
+		},
+		"toleration with a different value": {
+			tolerations: []interface{}{
+				map[string]interface{}{
+					"key":      "dedicated",
+					"operator": "Equal",
+					"value":    "cpu",
+					"effect":   "NoSchedule",
+				},
+			},
+			expectedNames: sets.NewString("cluster1", "cluster4"),
+		},
+		"tolerated NoExecute taint": {
+			tolerations: []interface{}{
+				map[string]interface{}{
+					"key":      "maintenance",
+					"operator": "Exists",
+				},
+			},
+			expectedNames: sets.NewString("cluster1", "cluster3", "cluster4"),
+		},
+		"untolerated NoSchedule taint retains propagated cluster": {
+			propagatedClusters: []string{"cluster2", "cluster3"},
+			expectedNames:      sets.NewString("cluster1", "cluster2", "cluster4"),
+		},
+	}
+
+	for testName, testCase := range testCases {
+		t.Run(testName, func(t *testing.T) {
+			obj := &unstructured.Unstructured{
+				Object: map[string]interface{}{
+					"spec": make(map[string]interface{}),
+				},
+			}
+			if err := unstructured.SetNestedStringMap(obj.Object, map[string]string{}, SpecField, PlacementField, ClusterSelectorField, MatchLabelsField); err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if testCase.tolerations != nil {
+				if err := unstructured.SetNestedSlice(obj.Object, testCase.tolerations, SpecField, PlacementField, TolerationsField); err != nil {
+					t.Fatalf("Unexpected error: %v", err)
+				}
+			}
+			var statusClusters []interface{}
+			for _, clusterName := range testCase.propagatedClusters {
+				statusClusters = append(statusClusters, map[string]interface{}{
+					NameField: clusterName,
+				})
+			}
+			if err := unstructured.SetNestedSlice(obj.Object, statusClusters, StatusField, ClustersField); err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+
+			selectedNames, err := selectedClusterNames(obj, clusters, false)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if !reflect.DeepEqual(selectedNames, testCase.expectedNames) {
+				t.Fatalf("Expected names %v, got %v", testCase.expectedNames, selectedNames)
+			}
+		})
+	}
+}
+
+func TestComputePlacementWithSpreadConstraint(t *testing.T) {
+	newCluster := func(name, region string, zones ...string) *fedv1b1.KubeFedCluster {
+		cluster := &fedv1b1.KubeFedCluster{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: name,
+			},
+			Status: fedv1b1.KubeFedClusterStatus{
+				Zones: zones,
+			},
+		}
+		if len(region) > 0 {
+			cluster.Status.Region = &region
+		}
+		return cluster
+	}
+	clusters := []*fedv1b1.KubeFedCluster{
+		newCluster("cluster1", "us", "us-a"),
+		newCluster("cluster2", "us", "us-b"),
+		newCluster("cluster3", "eu", "eu-a"),
+		newCluster("cluster4", "eu", "eu-a"),
+		newCluster("cluster5", "ap", "ap-a"),
+		newCluster("cluster6", ""),
+	}
+	regions := map[string]string{
+		"cluster1": "us",
+		"cluster2": "us",
+		"cluster3": "eu",
+		"cluster4": "eu",
+		"cluster5": "ap",
+	}
+
+	testCases := map[string]struct {
+		constraint      map[string]interface{}
+		expectedCount   int
+		expectedNames   sets.String
+		maxPerRegion    int
+		expectedFailure bool
+	}{
+		"all clusters with topology when no limits are set": {
+			constraint: map[string]interface{}{
+				"spreadBy": "Region",
+			},
+			expectedNames: sets.NewString("cluster1", "cluster2", "cluster3", "cluster4", "cluster5"),
+		},
+		"one cluster per region": {
+			constraint: map[string]interface{}{
+				"spreadBy":            "Region",
+				"maxClustersPerGroup": int64(1),
+			},
+			expectedCount: 3,
+			maxPerRegion:  1,
+		},
+		"one cluster in each of two regions": {
+			constraint: map[string]interface{}{
+				"spreadBy":            "Region",
+				"maxGroups":           int64(2),
+				"maxClustersPerGroup": int64(1),
+			},
+			expectedCount: 2,
+			maxPerRegion:  1,
+		},
+		"one cluster per zone": {
+			constraint: map[string]interface{}{
+				"spreadBy":            "Zone",
+				"maxClustersPerGroup": int64(1),
+			},
+			expectedCount: 4,
+		},
+		"invalid spreadBy": {
+			constraint: map[string]interface{}{
+				"spreadBy": "Planet",
+			},
+			expectedFailure: true,
+		},
+	}
+
+	for testName, testCase := range testCases {
+		t.Run(testName, func(t *testing.T) {
+			obj := &unstructured.Unstructured{
+				Object: map[string]interface{}{
+					"metadata": map[string]interface{}{
+						"name":      "foo",
+						"namespace": "bar",
+					},
+					"spec": make(map[string]interface{}),
+				},
+			}
+			if err := unstructured.SetNestedStringMap(obj.Object, map[string]string{}, SpecField, PlacementField, ClusterSelectorField, MatchLabelsField); err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if err := unstructured.SetNestedMap(obj.Object, testCase.constraint, SpecField, PlacementField, SpreadConstraintField); err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+
+			selectedNames, err := ComputePlacement(obj, clusters, false)
+			if testCase.expectedFailure {
+				if err == nil {
+					t.Fatalf("Expected an error but got none")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if testCase.expectedNames != nil && !reflect.DeepEqual(selectedNames, testCase.expectedNames) {
+				t.Fatalf("Expected names %v, got %v", testCase.expectedNames, selectedNames)
+			}
+			if testCase.expectedCount > 0 && selectedNames.Len() != testCase.expectedCount {
+				t.Fatalf("Expected %d clusters, got %v", testCase.expectedCount, selectedNames)
+			}
+			if testCase.maxPerRegion > 0 {
+				regionCounts := make(map[string]int)
+				for _, name := range selectedNames.List() {
+					regionCounts[regions[name]]++
+					if regionCounts[regions[name]] > testCase.maxPerRegion {
+						t.Fatalf("Expected at most %d clusters in region %q, got %v", testCase.maxPerRegion, regions[name], selectedNames)
+					}
+				}
+			}
+
+			// Placement must be stable for a given resource.
+			for i := 0; i < 5; i++ {
+				names, err := ComputePlacement(obj, clusters, false)
+				if err != nil {
+					t.Fatalf("Unexpected error: %v", err)
+				}
+				if !reflect.DeepEqual(names, selectedNames) {
+					t.Fatalf("Expected stable placement %v, got %v", selectedNames, names)
+				}
+			}
+		})
+	}
+}
+
+func TestComputeNamespacedPlacementDecisions(t *testing.T) {
+	newCluster := func(name string, ready bool, labels map[string]string) *fedv1b1.KubeFedCluster {
+		cluster := &fedv1b1.KubeFedCluster{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:   name,
+				Labels: labels,
+			},
+		}
+		if ready {
+			cluster.Status.Conditions = []fedv1b1.ClusterCondition{
+				{
+					Type:   common.ClusterReady,
+					Status: corev1.ConditionTrue,
+				},
+			}
+		}
+		return cluster
+	}
+	clusters := []*fedv1b1.KubeFedCluster{
+		newCluster("cluster1", true, map[string]string{"foo": "bar"}),
+		newCluster("cluster2", false, map[string]string{"foo": "bar"}),
+		newCluster("cluster3", true, map[string]string{"foo": "bar"}),
+		newCluster("cluster4", true, nil),
+	}
+	newObject := func(clusterNames []string, matchLabels map[string]string) *unstructured.Unstructured {
+		obj := &unstructured.Unstructured{
+			Object: map[string]interface{}{
+				"spec": make(map[string]interface{}),
+			},
+		}
+		if err := SetClusterNames(obj, clusterNames); err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if matchLabels != nil {
+			if err := unstructured.SetNestedStringMap(obj.Object, matchLabels, SpecField, PlacementField, ClusterSelectorField, MatchLabelsField); err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+		}
+		return obj
+	}
+
+	testCases := map[string]struct {
+		resource          *unstructured.Unstructured
+		namespace         *unstructured.Unstructured
+		limitedScope      bool
+		expectedNames     sets.String
+		expectedDecisions PlacementDecisions
+	}{
+		"clusters not matching the selector": {
+			resource:      newObject(nil, map[string]string{"foo": "bar"}),
+			namespace:     newObject(nil, map[string]string{}),
+			expectedNames: sets.NewString("cluster1", "cluster2", "cluster3"),
+			expectedDecisions: PlacementDecisions{
+				"cluster1": PlacementSelected,
+				"cluster2": PlacementClusterNotReady,
+				"cluster3": PlacementSelected,
+				"cluster4": PlacementNotSelected,
+			},
+		},
+		"clusters excluded by namespace placement": {
+			resource:      newObject([]string{"cluster1", "cluster3", "cluster5"}, nil),
+			namespace:     newObject([]string{"cluster1"}, nil),
+			expectedNames: sets.NewString("cluster1"),
+			expectedDecisions: PlacementDecisions{
+				"cluster1": PlacementSelected,
+				"cluster2": PlacementNotSelected,
+				"cluster3": PlacementExcludedByNamespace,
+				"cluster4": PlacementNotSelected,
+				"cluster5": PlacementClusterUnknown,
+			},
+		},
+		"all clusters excluded when namespace is not federated": {
+			resource:      newObject([]string{"cluster1"}, nil),
+			expectedNames: sets.NewString(),
+			expectedDecisions: PlacementDecisions{
+				"cluster1": PlacementExcludedByNamespace,
+				"cluster2": PlacementNotSelected,
+				"cluster3": PlacementNotSelected,
+				"cluster4": PlacementNotSelected,
+			},
+		},
+		"resource placement used verbatim for limited scope": {
+			resource:      newObject([]string{"cluster1"}, nil),
+			limitedScope:  true,
+			expectedNames: sets.NewString("cluster1"),
+			expectedDecisions: PlacementDecisions{
+				"cluster1": PlacementSelected,
+				"cluster2": PlacementNotSelected,
+				"cluster3": PlacementNotSelected,
+				"cluster4": PlacementNotSelected,
+			},
+		},
+	}
+
+	for testName, testCase := range testCases {
+		t.Run(testName, func(t *testing.T) {
+			selectedNames, decisions, err := ComputeNamespacedPlacementDecisions(testCase.resource, testCase.namespace, clusters, testCase.limitedScope, false)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if !reflect.DeepEqual(selectedNames, testCase.expectedNames) {
+				t.Fatalf("Expected names %v, got %v", testCase.expectedNames, selectedNames)
+			}
+			if !reflect.DeepEqual(decisions, testCase.expectedDecisions) {
+				t.Fatalf("Expected decisions %v, got %v", testCase.expectedDecisions, decisions)
+			}
+		})
+	}
+}
+
+func TestComputePlacementWithFailover(t *testing.T) {
+	newCluster := func(name string, notReadyFor time.Duration) *fedv1b1.KubeFedCluster {
+		transitionTime := metav1.NewTime(time.Now().Add(-notReadyFor))
+		status := corev1.ConditionTrue
+		if notReadyFor > 0 {
+			status = corev1.ConditionFalse
+		}
+		return &fedv1b1.KubeFedCluster{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: name,
+			},
+			Status: fedv1b1.KubeFedClusterStatus{
+				Conditions: []fedv1b1.ClusterCondition{
+					{
+						Type:               common.ClusterReady,
+						Status:             status,
+						LastTransitionTime: &transitionTime,
+					},
+				},
+			},
+		}
+	}
+	clusters := []*fedv1b1.KubeFedCluster{
+		newCluster("cluster1", 0),
+		newCluster("cluster2", 10*time.Minute),
+		newCluster("cluster3", 10*time.Second),
+		newCluster("cluster4", 10*time.Minute),
+		newCluster("backup1", 0),
+		newCluster("backup2", 0),
+		newCluster("backup3", 10*time.Minute),
+	}
+
+	testCases := map[string]struct {
+		clusterNames      []string
+		failover          map[string]interface{}
+		expectedNames     sets.String
+		expectedDecisions map[string]PlacementReason
+		expectedPending   bool
+	}{
+		"no replacement without a failover policy": {
+			clusterNames:  []string{"cluster1", "cluster2"},
+			expectedNames: sets.NewString("cluster1", "cluster2"),
+		},
+		"first ready backup cluster replaces a cluster past the grace period": {
+			clusterNames: []string{"cluster1", "cluster2"},
+			failover: map[string]interface{}{
+				"gracePeriodSeconds": int64(60),
+				"backupClusters": []interface{}{
+					map[string]interface{}{"name": "backup3"},
+					map[string]interface{}{"name": "backup1"},
+					map[string]interface{}{"name": "backup2"},
+				},
+			},
+			expectedNames: sets.NewString("cluster1", "cluster2", "backup1"),
+			expectedDecisions: map[string]PlacementReason{
+				"cluster2": PlacementClusterNotReady,
+				"backup1":  PlacementFailoverReplacement,
+				"backup2":  PlacementNotSelected,
+			},
+		},
+		"no replacement within the grace period": {
+			clusterNames: []string{"cluster1", "cluster3"},
+			failover: map[string]interface{}{
+				"gracePeriodSeconds": int64(60),
+				"backupClusters": []interface{}{
+					map[string]interface{}{"name": "backup1"},
+				},
+			},
+			expectedNames: sets.NewString("cluster1", "cluster3"),
+			expectedDecisions: map[string]PlacementReason{
+				"cluster3": PlacementClusterNotReady,
+				"backup1":  PlacementNotSelected,
+			},
+			expectedPending: true,
+		},
+		"each failed cluster is replaced": {
+			clusterNames: []string{"cluster2", "cluster4"},
+			failover: map[string]interface{}{
+				"gracePeriodSeconds": int64(60),
+				"backupClusters": []interface{}{
+					map[string]interface{}{"name": "backup1"},
+					map[string]interface{}{"name": "backup2"},
+				},
+			},
+			expectedNames: sets.NewString("cluster2", "cluster4", "backup1", "backup2"),
+		},
+		"selected backup cluster is not used as a replacement": {
+			clusterNames: []string{"cluster2", "backup1"},
+			failover: map[string]interface{}{
+				"gracePeriodSeconds": int64(60),
+				"backupClusters": []interface{}{
+					map[string]interface{}{"name": "backup1"},
+					map[string]interface{}{"name": "backup2"},
+				},
+			},
+			expectedNames: sets.NewString("cluster2", "backup1", "backup2"),
+		},
+	}
+
+	for testName, testCase := range testCases {
+		t.Run(testName, func(t *testing.T) {
+			obj := &unstructured.Unstructured{
+				Object: map[string]interface{}{
+					"spec": make(map[string]interface{}),
+				},
+			}
+			if err := SetClusterNames(obj, testCase.clusterNames); err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if testCase.failover != nil {
+				if err := unstructured.SetNestedMap(obj.Object, testCase.failover, SpecField, PlacementField, FailoverField); err != nil {
+					t.Fatalf("Unexpected error: %v", err)
+				}
+			}
+
+			selectedNames, decisions, err := ComputePlacementDecisions(obj, clusters, false)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if !reflect.DeepEqual(selectedNames, testCase.expectedNames) {
+				t.Fatalf("Expected names %v, got %v", testCase.expectedNames, selectedNames)
+			}
+			for clusterName, reason := range testCase.expectedDecisions {
+				if decisions[clusterName] != reason {
+					t.Fatalf("Expected decision %q for cluster %q, got %q", reason, clusterName, decisions[clusterName])
+				}
+			}
+
+			_, pending, err := PendingFailoverDelay(obj, selectedNames, clusters)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if pending != testCase.expectedPending {
+				t.Fatalf("Expected pending failover to be %v, got %v", testCase.expectedPending, pending)
+			}
+		})
+	}
+}
+
+func TestComputePlacementWithMaxClusters(t *testing.T) {
+	var clusters []*fedv1b1.KubeFedCluster
+	for _, name := range []string{"cluster1", "cluster2", "cluster3", "cluster4", "cluster5"} {
+		clusters = append(clusters, &fedv1b1.KubeFedCluster{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: name,
+				Labels: map[string]string{
+					"tier": "prod",
+				},
+			},
+		})
+	}
+	newObject := func(maxClusters int64, previouslySelected []string) *unstructured.Unstructured {
+		obj := &unstructured.Unstructured{
+			Object: map[string]interface{}{
+				"metadata": map[string]interface{}{
+					"name":      "foo",
+					"namespace": "bar",
+				},
+				"spec": make(map[string]interface{}),
+			},
+		}
+		if err := unstructured.SetNestedStringMap(obj.Object, map[string]string{"tier": "prod"}, SpecField, PlacementField, ClusterSelectorField, MatchLabelsField); err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if err := unstructured.SetNestedField(obj.Object, maxClusters, SpecField, PlacementField, MaxClustersField); err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		var placement []interface{}
+		for _, name := range previouslySelected {
+			placement = append(placement, map[string]interface{}{
+				"name":   name,
+				"reason": string(PlacementSelected),
+			})
+		}
+		if err := unstructured.SetNestedSlice(obj.Object, placement, StatusField, PlacementField); err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		return obj
+	}
+
+	selectedNames, decisions, err := ComputePlacementDecisions(newObject(3, nil), clusters, false)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if selectedNames.Len() != 3 {
+		t.Fatalf("Expected 3 clusters, got %v", selectedNames)
+	}
+	for _, cluster := range clusters {
+		if !selectedNames.Has(cluster.Name) && decisions[cluster.Name] != PlacementExcludedByMaxClusters {
+			t.Fatalf("Expected decision %q for cluster %q, got %q", PlacementExcludedByMaxClusters, cluster.Name, decisions[cluster.Name])
+		}
+	}
+
+	// The choice must not change when a new candidate is added.
+	newClusters := append([]*fedv1b1.KubeFedCluster{}, clusters...)
+	for _, name := range []string{"cluster6", "cluster7", "cluster8"} {
+		newClusters = append(newClusters, &fedv1b1.KubeFedCluster{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: name,
+				Labels: map[string]string{
+					"tier": "prod",
+				},
+			},
+		})
+	}
+	obj := newObject(3, selectedNames.List())
+	names, err := ComputePlacement(obj, newClusters, false)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if !reflect.DeepEqual(names, selectedNames) {
+		t.Fatalf("Expected placement %v to be unchanged, got %v", selectedNames, names)
+	}
+
+	// Only a chosen cluster that leaves the candidate set is replaced.
+	removedName := selectedNames.List()[0]
+	var remainingClusters []*fedv1b1.KubeFedCluster
+	for _, cluster := range clusters {
+		if cluster.Name != removedName {
+			remainingClusters = append(remainingClusters, cluster)
+		}
+	}
+	names, err = ComputePlacement(obj, remainingClusters, false)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if names.Len() != 3 {
+		t.Fatalf("Expected 3 clusters, got %v", names)
+	}
+	if !names.IsSuperset(selectedNames.Difference(sets.NewString(removedName))) {
+		t.Fatalf("Expected placement %v to retain the clusters of %v other than %q", names, selectedNames, removedName)
+	}
+
+	// All candidates are selected when there are fewer than the maximum.
+	names, err = ComputePlacement(newObject(10, nil), clusters, false)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if names.Len() != len(clusters) {
+		t.Fatalf("Expected all clusters to be selected, got %v", names)
+	}
+}
+
+func TestComputePlacementWithUnschedulableClusters(t *testing.T) {
+	clusters := []*fedv1b1.KubeFedCluster{
+		{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "cluster1",
+				Labels: map[string]string{
+					"foo": "bar",
+				},
+			},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "cluster2",
+				Labels: map[string]string{
+					"foo": "bar",
+				},
+			},
+			Spec: fedv1b1.KubeFedClusterSpec{
+				Unschedulable: true,
+			},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "cluster3",
+				Labels: map[string]string{
+					"foo": "bar",
+				},
+			},
+			Spec: fedv1b1.KubeFedClusterSpec{
+				Unschedulable: true,
+				Drain:         true,
+			},
+		},
+	}
+
+	testCases := map[string]struct {
+		clusterNames       []string
+		propagatedClusters []string
+		expectedNames      sets.String
+		expectedDecisions  PlacementDecisions
+	}{
+		"cordoned and draining clusters are not selected by label": {
+			expectedNames: sets.NewString("cluster1"),
+			expectedDecisions: PlacementDecisions{
+				"cluster1": PlacementSelected,
+				"cluster2": PlacementClusterCordoned,
+				"cluster3": PlacementClusterDraining,
+			},
+		},
+		"cordoned cluster is retained if already propagated": {
+			propagatedClusters: []string{"cluster2", "cluster3"},
+			expectedNames:      sets.NewString("cluster1", "cluster2"),
+			expectedDecisions: PlacementDecisions{
+				"cluster1": PlacementSelected,
+				"cluster2": PlacementSelected,
+				"cluster3": PlacementClusterDraining,
+			},
+		},
+		"draining cluster is treated as cordoned when selected by name": {
+			clusterNames:       []string{"cluster2", "cluster3"},
+			propagatedClusters: []string{"cluster3"},
+			expectedNames:      sets.NewString("cluster3"),
+			expectedDecisions: PlacementDecisions{
+				"cluster1": PlacementNotSelected,
+				"cluster2": PlacementClusterCordoned,
+				"cluster3": PlacementSelected,
+			},
+		},
+	}
+
+	for _, cluster := range clusters {
+		cluster.Status.Conditions = []fedv1b1.ClusterCondition{
+			{
+				Type:   common.ClusterReady,
+				Status: corev1.ConditionTrue,
+			},
+		}
+	}
+
+	for testName, tc := range testCases {
+		t.Run(testName, func(t *testing.T) {
+			obj := &unstructured.Unstructured{
+				Object: map[string]interface{}{
+					"spec": make(map[string]interface{}),
+				},
+			}
+			if tc.clusterNames != nil {
+				if err := SetClusterNames(obj, tc.clusterNames); err != nil {
+					t.Fatalf("Unexpected error: %v", err)
+				}
+			} else if err := SetClusterSelector(obj, map[string]string{"foo": "bar"}); err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			var propagated []interface{}
+			for _, name := range tc.propagatedClusters {
+				propagated = append(propagated, map[string]interface{}{"name": name})
+			}
+			if err := unstructured.SetNestedSlice(obj.Object, propagated, StatusField, ClustersField); err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+
+			selectedNames, decisions, err := ComputePlacementDecisions(obj, clusters, false)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if !reflect.DeepEqual(selectedNames, tc.expectedNames) {
+				t.Fatalf("Expected names %v, got %v", tc.expectedNames, selectedNames)
+			}
+			if !reflect.DeepEqual(decisions, tc.expectedDecisions) {
+				t.Fatalf("Expected decisions %v, got %v", tc.expectedDecisions, decisions)
+			}
+		})
+	}
+}
@@ -0,0 +1,212 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package util
+
+import (
+	"sync"
+
+	"github.com/pkg/errors"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/klog/v2"
+	runtimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+
+	policyv1a1 "sigs.k8s.io/kubefed/pkg/apis/policy/v1alpha1"
+)
+
+// PolicyInformers provides the propagation and override policies to
+// the sync controllers of all federated types from a single set of
+// informers.  The informer of a policy kind whose CRD is not
+// installed is omitted, and no policies of that kind are provided.
+// The informers of the cluster-scoped policy kinds are omitted if
+// the control plane is limited to a single namespace.
+type PolicyInformers struct {
+	propagationPolicies        *policyInformer
+	clusterPropagationPolicies *policyInformer
+	overridePolicies           *policyInformer
+	clusterOverridePolicies    *policyInformer
+
+	handlerLock sync.RWMutex
+	handlers    map[*policyHandler]struct{}
+}
+
+type policyInformer struct {
+	store      cache.Store
+	controller cache.Controller
+}
+
+type policyHandler struct {
+	handle func(runtimeclient.Object)
+}
+
+func NewPolicyInformers(controllerConfig *ControllerConfig) (*PolicyInformers, error) {
+	p := &PolicyInformers{
+		handlers: make(map[*policyHandler]struct{}),
+	}
+	targetNamespace := controllerConfig.TargetNamespace
+
+	var err error
+	p.propagationPolicies, err = p.newInformer(controllerConfig, targetNamespace, &policyv1a1.PropagationPolicy{}, PropagationPolicyKind)
+	if err != nil {
+		return nil, err
+	}
+	p.overridePolicies, err = p.newInformer(controllerConfig, targetNamespace, &policyv1a1.OverridePolicy{}, OverridePolicyKind)
+	if err != nil {
+		return nil, err
+	}
+	if !controllerConfig.LimitedScope() {
+		p.clusterPropagationPolicies, err = p.newInformer(controllerConfig, "", &policyv1a1.ClusterPropagationPolicy{}, ClusterPropagationPolicyKind)
+		if err != nil {
+			return nil, err
+		}
+		p.clusterOverridePolicies, err = p.newInformer(controllerConfig, "", &policyv1a1.ClusterOverridePolicy{}, ClusterOverridePolicyKind)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return p, nil
+}
+
+// newInformer returns an informer for the given policy kind, or nil
+// if the API does not serve the kind.
+func (p *PolicyInformers) newInformer(controllerConfig *ControllerConfig, namespace string, obj runtimeclient.Object, kind string) (*policyInformer, error) {
+	store, controller, err := NewGenericInformer(controllerConfig.KubeConfig, namespace, obj, NoResyncPeriod, p.dispatch)
+	if meta.IsNoMatchError(err) {
+		klog.Warningf("The CRD for %s is not installed, policies of this kind will not be applied", kind)
+		return nil, nil
+	}
+	if err != nil {
+		return nil, errors.Wrapf(err, "Failed to create informer for %s", kind)
+	}
+	return &policyInformer{store: store, controller: controller}, nil
+}
+
+func (p *PolicyInformers) informers() []*policyInformer {
+	var informers []*policyInformer
+	for _, informer := range []*policyInformer{
+		p.propagationPolicies,
+		p.clusterPropagationPolicies,
+		p.overridePolicies,
+		p.clusterOverridePolicies,
+	} {
+		if informer != nil {
+			informers = append(informers, informer)
+		}
+	}
+	return informers
+}
+
+func (p *PolicyInformers) Run(stopChan <-chan struct{}) {
+	for _, informer := range p.informers() {
+		go informer.controller.Run(stopChan)
+	}
+}
+
+func (p *PolicyInformers) HasSynced() bool {
+	for _, informer := range p.informers() {
+		if !informer.controller.HasSynced() {
+			return false
+		}
+	}
+	return true
+}
+
+// AddEventHandler registers a function to be called with every
+// policy that is added, updated or deleted until the stop channel is
+// closed.
+func (p *PolicyInformers) AddEventHandler(handle func(runtimeclient.Object), stopChan <-chan struct{}) {
+	handler := &policyHandler{handle: handle}
+	p.handlerLock.Lock()
+	p.handlers[handler] = struct{}{}
+	p.handlerLock.Unlock()
+
+	go func() {
+		<-stopChan
+		p.handlerLock.Lock()
+		defer p.handlerLock.Unlock()
+		delete(p.handlers, handler)
+	}()
+}
+
+func (p *PolicyInformers) dispatch(obj runtimeclient.Object) {
+	p.handlerLock.RLock()
+	handlers := make([]*policyHandler, 0, len(p.handlers))
+	for handler := range p.handlers {
+		handlers = append(handlers, handler)
+	}
+	p.handlerLock.RUnlock()
+	for _, handler := range handlers {
+		handler.handle(obj)
+	}
+}
+
+// PropagationPolicies returns the propagation policies in the given
+// namespace.
+func (p *PolicyInformers) PropagationPolicies(namespace string) []*policyv1a1.PropagationPolicy {
+	var policies []*policyv1a1.PropagationPolicy
+	for _, obj := range p.policiesInNamespace(p.propagationPolicies, namespace) {
+		policies = append(policies, obj.(*policyv1a1.PropagationPolicy))
+	}
+	return policies
+}
+
+func (p *PolicyInformers) ClusterPropagationPolicies() []*policyv1a1.ClusterPropagationPolicy {
+	if p.clusterPropagationPolicies == nil {
+		return nil
+	}
+	var policies []*policyv1a1.ClusterPropagationPolicy
+	for _, obj := range p.clusterPropagationPolicies.store.List() {
+		policies = append(policies, obj.(*policyv1a1.ClusterPropagationPolicy))
+	}
+	return policies
+}
+
+// OverridePolicies returns the override policies in the given
+// namespace.
+func (p *PolicyInformers) OverridePolicies(namespace string) []*policyv1a1.OverridePolicy {
+	var policies []*policyv1a1.OverridePolicy
+	for _, obj := range p.policiesInNamespace(p.overridePolicies, namespace) {
+		policies = append(policies, obj.(*policyv1a1.OverridePolicy))
+	}
+	return policies
+}
+
+func (p *PolicyInformers) ClusterOverridePolicies() []*policyv1a1.ClusterOverridePolicy {
+	if p.clusterOverridePolicies == nil {
+		return nil
+	}
+	var policies []*policyv1a1.ClusterOverridePolicy
+	for _, obj := range p.clusterOverridePolicies.store.List() {
+		policies = append(policies, obj.(*policyv1a1.ClusterOverridePolicy))
+	}
+	return policies
+}
+
+func (p *PolicyInformers) policiesInNamespace(informer *policyInformer, namespace string) []runtimeclient.Object {
+	if informer == nil || namespace == "" {
+		return nil
+	}
+	var policies []runtimeclient.Object
+	for _, rawObj := range informer.store.List() {
+		obj := rawObj.(runtimeclient.Object)
+		if obj.GetNamespace() == namespace {
+			policies = append(policies, obj)
+		}
+	}
+	return policies
+}
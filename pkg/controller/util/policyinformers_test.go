@@ -0,0 +1,114 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package util
+
+import (
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/tools/cache"
+	runtimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+
+	policyv1a1 "sigs.k8s.io/kubefed/pkg/apis/policy/v1alpha1"
+)
+
+func TestPolicyInformersWithMissingKinds(t *testing.T) {
+	store := cache.NewStore(cache.MetaNamespaceKeyFunc)
+	for _, namespace := range []string{"ns1", "ns2"} {
+		policy := &policyv1a1.PropagationPolicy{
+			ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: "policy"},
+		}
+		if err := store.Add(policy); err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+	}
+	// Only the CRD of propagation policies is installed.
+	p := &PolicyInformers{
+		propagationPolicies: &policyInformer{store: store, controller: syncedController{}},
+		handlers:            make(map[*policyHandler]struct{}),
+	}
+
+	if !p.HasSynced() {
+		t.Fatalf("Expected the policy informers to be synced")
+	}
+	policies := p.PropagationPolicies("ns1")
+	if len(policies) != 1 || policies[0].Namespace != "ns1" {
+		t.Fatalf("Expected the propagation policy in ns1, got %v", policies)
+	}
+	if policies := p.PropagationPolicies(""); len(policies) != 0 {
+		t.Fatalf("Expected no propagation policies for an empty namespace, got %v", policies)
+	}
+	if policies := p.ClusterPropagationPolicies(); len(policies) != 0 {
+		t.Fatalf("Expected no cluster propagation policies, got %v", policies)
+	}
+	if policies := p.OverridePolicies("ns1"); len(policies) != 0 {
+		t.Fatalf("Expected no override policies, got %v", policies)
+	}
+	if policies := p.ClusterOverridePolicies(); len(policies) != 0 {
+		t.Fatalf("Expected no cluster override policies, got %v", policies)
+	}
+}
+
+func TestPolicyInformersEventHandlers(t *testing.T) {
+	p := &PolicyInformers{
+		handlers: make(map[*policyHandler]struct{}),
+	}
+	policy := &policyv1a1.OverridePolicy{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "ns1", Name: "policy"},
+	}
+
+	stopChan1 := make(chan struct{})
+	stopChan2 := make(chan struct{})
+	defer close(stopChan2)
+	var handled1, handled2 int
+	p.AddEventHandler(func(runtimeclient.Object) { handled1++ }, stopChan1)
+	p.AddEventHandler(func(runtimeclient.Object) { handled2++ }, stopChan2)
+
+	p.dispatch(policy)
+	if handled1 != 1 || handled2 != 1 {
+		t.Fatalf("Expected both handlers to be called once, got %d and %d", handled1, handled2)
+	}
+
+	close(stopChan1)
+	err := wait.PollImmediate(10*time.Millisecond, wait.ForeverTestTimeout, func() (bool, error) {
+		p.handlerLock.RLock()
+		defer p.handlerLock.RUnlock()
+		return len(p.handlers) == 1, nil
+	})
+	if err != nil {
+		t.Fatalf("Expected the handler to be removed when its stop channel is closed")
+	}
+
+	p.dispatch(policy)
+	if handled1 != 1 || handled2 != 2 {
+		t.Fatalf("Expected only the remaining handler to be called, got %d and %d", handled1, handled2)
+	}
+}
+
+type syncedController struct{}
+
+func (syncedController) Run(stopCh <-chan struct{}) {}
+
+func (syncedController) HasSynced() bool {
+	return true
+}
+
+func (syncedController) LastSyncResourceVersion() string {
+	return ""
+}
@@ -44,8 +44,11 @@ func ObjectVersion(clusterObj *unstructured.Unstructured) string {
 
 // ObjectNeedsUpdate determines whether the 2 objects provided cluster
 // object needs to be updated according to the desired object and the
-// recorded version.
-func ObjectNeedsUpdate(desiredObj, clusterObj *unstructured.Unstructured, recordedVersion string) bool {
+// recorded version. When the cluster object is propagated with
+// server-side apply, other field managers may add labels and
+// annotations, so the cluster object only needs to contain those of
+// the desired object.
+func ObjectNeedsUpdate(desiredObj, clusterObj *unstructured.Unstructured, recordedVersion string, serverSideApply bool) bool {
 	targetVersion := ObjectVersion(clusterObj)
 
 	if recordedVersion != targetVersion {
@@ -55,7 +58,13 @@ func ObjectNeedsUpdate(desiredObj, clusterObj *unstructured.Unstructured, record
 	// If versions match and the version is sourced from the
 	// generation field, a further check of metadata equivalency is
 	// required.
-	return strings.HasPrefix(targetVersion, generationPrefix) && !ObjectMetaObjEquivalent(desiredObj, clusterObj)
+	if !strings.HasPrefix(targetVersion, generationPrefix) {
+		return false
+	}
+	if serverSideApply {
+		return !ObjectMetaObjContained(desiredObj, clusterObj)
+	}
+	return !ObjectMetaObjEquivalent(desiredObj, clusterObj)
 }
 
 // SortClusterVersions ASCII sorts the given cluster versions slice
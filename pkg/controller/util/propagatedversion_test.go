@@ -0,0 +1,100 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package util
+
+import (
+	"testing"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func TestObjectNeedsUpdate(t *testing.T) {
+	newObj := func(generation int64, labels map[string]string) *unstructured.Unstructured {
+		obj := &unstructured.Unstructured{}
+		obj.SetName("foo")
+		obj.SetNamespace("bar")
+		obj.SetGeneration(generation)
+		obj.SetResourceVersion("10")
+		obj.SetLabels(labels)
+		return obj
+	}
+	desiredLabels := map[string]string{"app": "foo"}
+	addedLabels := map[string]string{"app": "foo", "injected": "true"}
+
+	testCases := map[string]struct {
+		desiredObj      *unstructured.Unstructured
+		clusterObj      *unstructured.Unstructured
+		recordedVersion string
+		serverSideApply bool
+		expectedResult  bool
+	}{
+		"update needed when the recorded version differs": {
+			desiredObj:      newObj(0, desiredLabels),
+			clusterObj:      newObj(2, desiredLabels),
+			recordedVersion: "gen:1",
+			expectedResult:  true,
+		},
+		"update not needed when the generation and metadata match": {
+			desiredObj:      newObj(0, desiredLabels),
+			clusterObj:      newObj(2, desiredLabels),
+			recordedVersion: "gen:2",
+			expectedResult:  false,
+		},
+		"update not needed when the resource version matches": {
+			desiredObj:      newObj(0, desiredLabels),
+			clusterObj:      newObj(0, addedLabels),
+			recordedVersion: "rv:10",
+			expectedResult:  false,
+		},
+		"update needed when the cluster object has additional labels": {
+			desiredObj:      newObj(0, desiredLabels),
+			clusterObj:      newObj(2, addedLabels),
+			recordedVersion: "gen:2",
+			expectedResult:  true,
+		},
+		"apply not needed when the cluster object has additional labels": {
+			desiredObj:      newObj(0, desiredLabels),
+			clusterObj:      newObj(2, addedLabels),
+			recordedVersion: "gen:2",
+			serverSideApply: true,
+			expectedResult:  false,
+		},
+		"apply needed when the cluster object is missing a label": {
+			desiredObj:      newObj(0, addedLabels),
+			clusterObj:      newObj(2, desiredLabels),
+			recordedVersion: "gen:2",
+			serverSideApply: true,
+			expectedResult:  true,
+		},
+		"apply needed when the recorded version differs": {
+			desiredObj:      newObj(0, desiredLabels),
+			clusterObj:      newObj(3, desiredLabels),
+			recordedVersion: "gen:2",
+			serverSideApply: true,
+			expectedResult:  true,
+		},
+	}
+
+	for testName, testCase := range testCases {
+		t.Run(testName, func(t *testing.T) {
+			result := ObjectNeedsUpdate(testCase.desiredObj, testCase.clusterObj, testCase.recordedVersion, testCase.serverSideApply)
+			if result != testCase.expectedResult {
+				t.Fatalf("Expected %v, got %v", testCase.expectedResult, result)
+			}
+		})
+	}
+}
@@ -0,0 +1,170 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package util
+
+import (
+	"sort"
+
+	"github.com/pkg/errors"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/klog/v2"
+
+	policyv1a1 "sigs.k8s.io/kubefed/pkg/apis/policy/v1alpha1"
+)
+
+const (
+	PropagationPolicyKind        = "PropagationPolicy"
+	ClusterPropagationPolicyKind = "ClusterPropagationPolicy"
+)
+
+// PolicyReference identifies a policy that applies to a federated
+// resource.
+type PolicyReference struct {
+	Kind      string `json:"kind"`
+	Namespace string `json:"namespace,omitempty"`
+	Name      string `json:"name"`
+}
+
+// ApplyPropagationPolicy returns a copy of the given federated
+// resource whose placement has been defaulted from the propagation
+// policy that applies to it, along with a reference to that policy.
+// The resource is returned unchanged with a nil reference if it
+// specifies either clusters or a cluster selector, or if no policy
+// selects it.
+//
+// A PropagationPolicy in the namespace of the resource takes
+// precedence over a ClusterPropagationPolicy. Where more than one
+// policy of the same scope selects the resource, the policy with the
+// highest priority applies and ties are broken by name.
+func ApplyPropagationPolicy(resource *unstructured.Unstructured, policies []*policyv1a1.PropagationPolicy, clusterPolicies []*policyv1a1.ClusterPropagationPolicy) (*unstructured.Unstructured, *PolicyReference, error) {
+	if resource == nil || hasExplicitPlacement(resource) {
+		return resource, nil, nil
+	}
+
+	var ref *PolicyReference
+	var placement *policyv1a1.Placement
+	if policy := selectPropagationPolicy(resource, policies); policy != nil {
+		ref = &PolicyReference{Kind: PropagationPolicyKind, Namespace: policy.Namespace, Name: policy.Name}
+		placement = &policy.Spec.Placement
+	} else if policy := selectClusterPropagationPolicy(resource, clusterPolicies); policy != nil {
+		ref = &PolicyReference{Kind: ClusterPropagationPolicyKind, Name: policy.Name}
+		placement = &policy.Spec.Placement
+	}
+	if placement == nil {
+		return resource, nil, nil
+	}
+
+	placementMap, err := runtime.DefaultUnstructuredConverter.ToUnstructured(placement)
+	if err != nil {
+		return nil, nil, errors.Wrapf(err, "Failed to convert placement of %s %q", ref.Kind, ref.Name)
+	}
+	// Placement fields set on the resource (e.g. tolerations) take
+	// precedence over those of the policy.
+	resourcePlacement, _, err := unstructured.NestedMap(resource.Object, SpecField, PlacementField)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "Failed to retrieve placement")
+	}
+	for key, value := range resourcePlacement {
+		placementMap[key] = value
+	}
+
+	defaulted := resource.DeepCopy()
+	if err := unstructured.SetNestedMap(defaulted.Object, placementMap, SpecField, PlacementField); err != nil {
+		return nil, nil, errors.Wrap(err, "Failed to set placement")
+	}
+	return defaulted, ref, nil
+}
+
+// hasExplicitPlacement indicates whether the resource specifies
+// either clusters or a cluster selector.
+func hasExplicitPlacement(resource *unstructured.Unstructured) bool {
+	for _, field := range []string{ClustersField, ClusterSelectorField} {
+		if _, ok, _ := unstructured.NestedFieldNoCopy(resource.Object, SpecField, PlacementField, field); ok {
+			return true
+		}
+	}
+	return false
+}
+
+func selectPropagationPolicy(resource *unstructured.Unstructured, policies []*policyv1a1.PropagationPolicy) *policyv1a1.PropagationPolicy {
+	var candidates []*policyv1a1.PropagationPolicy
+	for _, policy := range policies {
+		if policy.Namespace == resource.GetNamespace() && policySelectsResource(PropagationPolicyKind, policy.Name, policy.Spec.ResourceSelectors, false, resource) {
+			candidates = append(candidates, policy)
+		}
+	}
+	if len(candidates) == 0 {
+		return nil
+	}
+	sort.Slice(candidates, func(i, j int) bool {
+		return policyPrecedes(&candidates[i].ObjectMeta, &candidates[i].Spec, &candidates[j].ObjectMeta, &candidates[j].Spec)
+	})
+	return candidates[0]
+}
+
+func selectClusterPropagationPolicy(resource *unstructured.Unstructured, policies []*policyv1a1.ClusterPropagationPolicy) *policyv1a1.ClusterPropagationPolicy {
+	var candidates []*policyv1a1.ClusterPropagationPolicy
+	for _, policy := range policies {
+		if policySelectsResource(ClusterPropagationPolicyKind, policy.Name, policy.Spec.ResourceSelectors, true, resource) {
+			candidates = append(candidates, policy)
+		}
+	}
+	if len(candidates) == 0 {
+		return nil
+	}
+	sort.Slice(candidates, func(i, j int) bool {
+		return policyPrecedes(&candidates[i].ObjectMeta, &candidates[i].Spec, &candidates[j].ObjectMeta, &candidates[j].Spec)
+	})
+	return candidates[0]
+}
+
+func policyPrecedes(meta1 *metav1.ObjectMeta, spec1 *policyv1a1.PropagationPolicySpec, meta2 *metav1.ObjectMeta, spec2 *policyv1a1.PropagationPolicySpec) bool {
+	if spec1.Priority != spec2.Priority {
+		return spec1.Priority > spec2.Priority
+	}
+	return meta1.Name < meta2.Name
+}
+
+// policySelectsResource indicates whether any of the resource
+// selectors of a policy match the given resource. The namespace of a
+// selector is only considered for cluster-scoped policies.
+func policySelectsResource(policyKind, policyName string, resourceSelectors []policyv1a1.ResourceSelector, clusterScoped bool, resource *unstructured.Unstructured) bool {
+	for _, resourceSelector := range resourceSelectors {
+		if resourceSelector.Kind != resource.GetKind() {
+			continue
+		}
+		if clusterScoped && resourceSelector.Namespace != "" && resourceSelector.Namespace != resource.GetNamespace() {
+			continue
+		}
+		if resourceSelector.LabelSelector == nil {
+			return true
+		}
+		selector, err := metav1.LabelSelectorAsSelector(resourceSelector.LabelSelector)
+		if err != nil {
+			klog.Errorf("Ignoring invalid label selector of %s %q: %v", policyKind, policyName, err)
+			continue
+		}
+		if selector.Matches(labels.Set(resource.GetLabels())) {
+			return true
+		}
+	}
+	return false
+}
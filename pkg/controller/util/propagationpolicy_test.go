@@ -0,0 +1,232 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package util
+
+import (
+	"reflect"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	fedv1b1 "sigs.k8s.io/kubefed/pkg/apis/core/v1beta1"
+	policyv1a1 "sigs.k8s.io/kubefed/pkg/apis/policy/v1alpha1"
+)
+
+func TestApplyPropagationPolicy(t *testing.T) {
+	clusters := []*fedv1b1.KubeFedCluster{
+		{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "cluster1",
+				Labels: map[string]string{
+					"tier": "prod",
+				},
+			},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "cluster2",
+			},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "cluster3",
+			},
+		},
+	}
+
+	newPolicy := func(priority int32, kind string, labels map[string]string, clusterNames ...string) policyv1a1.PropagationPolicySpec {
+		spec := policyv1a1.PropagationPolicySpec{
+			ResourceSelectors: []policyv1a1.ResourceSelector{
+				{Kind: kind},
+			},
+			Priority: priority,
+		}
+		if labels != nil {
+			spec.ResourceSelectors[0].LabelSelector = &metav1.LabelSelector{MatchLabels: labels}
+		}
+		for _, clusterName := range clusterNames {
+			spec.Placement.Clusters = append(spec.Placement.Clusters, policyv1a1.ClusterReference{Name: clusterName})
+		}
+		return spec
+	}
+	namespaced := func(name string, spec policyv1a1.PropagationPolicySpec) *policyv1a1.PropagationPolicy {
+		return &policyv1a1.PropagationPolicy{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: name},
+			Spec:       spec,
+		}
+	}
+	clusterScoped := func(name string, spec policyv1a1.PropagationPolicySpec) *policyv1a1.ClusterPropagationPolicy {
+		return &policyv1a1.ClusterPropagationPolicy{
+			ObjectMeta: metav1.ObjectMeta{Name: name},
+			Spec:       spec,
+		}
+	}
+
+	testCases := map[string]struct {
+		placement        map[string]interface{}
+		policies         []*policyv1a1.PropagationPolicy
+		clusterPolicies  []*policyv1a1.ClusterPropagationPolicy
+		expectedPolicy   *PolicyReference
+		expectedClusters sets.String
+	}{
+		"no policy": {
+			expectedClusters: sets.NewString(),
+		},
+		"namespaced policy applies": {
+			policies: []*policyv1a1.PropagationPolicy{
+				namespaced("p1", newPolicy(0, "FederatedDeployment", nil, "cluster2")),
+			},
+			expectedPolicy:   &PolicyReference{Kind: PropagationPolicyKind, Namespace: "ns", Name: "p1"},
+			expectedClusters: sets.NewString("cluster2"),
+		},
+		"policy for another kind is ignored": {
+			policies: []*policyv1a1.PropagationPolicy{
+				namespaced("p1", newPolicy(0, "FederatedSecret", nil, "cluster2")),
+			},
+			expectedClusters: sets.NewString(),
+		},
+		"policy in another namespace is ignored": {
+			policies: []*policyv1a1.PropagationPolicy{
+				{
+					ObjectMeta: metav1.ObjectMeta{Namespace: "other", Name: "p1"},
+					Spec:       newPolicy(0, "FederatedDeployment", nil, "cluster2"),
+				},
+			},
+			expectedClusters: sets.NewString(),
+		},
+		"policy with non-matching label selector is ignored": {
+			policies: []*policyv1a1.PropagationPolicy{
+				namespaced("p1", newPolicy(0, "FederatedDeployment", map[string]string{"app": "other"}, "cluster2")),
+			},
+			expectedClusters: sets.NewString(),
+		},
+		"highest priority policy applies": {
+			policies: []*policyv1a1.PropagationPolicy{
+				namespaced("p1", newPolicy(0, "FederatedDeployment", nil, "cluster2")),
+				namespaced("p2", newPolicy(10, "FederatedDeployment", map[string]string{"app": "foo"}, "cluster3")),
+			},
+			expectedPolicy:   &PolicyReference{Kind: PropagationPolicyKind, Namespace: "ns", Name: "p2"},
+			expectedClusters: sets.NewString("cluster3"),
+		},
+		"policies with the same priority are ordered by name": {
+			policies: []*policyv1a1.PropagationPolicy{
+				namespaced("p2", newPolicy(0, "FederatedDeployment", nil, "cluster3")),
+				namespaced("p1", newPolicy(0, "FederatedDeployment", nil, "cluster2")),
+			},
+			expectedPolicy:   &PolicyReference{Kind: PropagationPolicyKind, Namespace: "ns", Name: "p1"},
+			expectedClusters: sets.NewString("cluster2"),
+		},
+		"namespaced policy takes precedence over cluster policy": {
+			policies: []*policyv1a1.PropagationPolicy{
+				namespaced("p1", newPolicy(0, "FederatedDeployment", nil, "cluster2")),
+			},
+			clusterPolicies: []*policyv1a1.ClusterPropagationPolicy{
+				clusterScoped("c1", newPolicy(100, "FederatedDeployment", nil, "cluster3")),
+			},
+			expectedPolicy:   &PolicyReference{Kind: PropagationPolicyKind, Namespace: "ns", Name: "p1"},
+			expectedClusters: sets.NewString("cluster2"),
+		},
+		"cluster policy applies": {
+			clusterPolicies: []*policyv1a1.ClusterPropagationPolicy{
+				clusterScoped("c1", newPolicy(0, "FederatedDeployment", map[string]string{"app": "foo"}, "cluster3")),
+			},
+			expectedPolicy:   &PolicyReference{Kind: ClusterPropagationPolicyKind, Name: "c1"},
+			expectedClusters: sets.NewString("cluster3"),
+		},
+		"explicit clusters take precedence over policy": {
+			placement: map[string]interface{}{
+				ClustersField: []interface{}{
+					map[string]interface{}{"name": "cluster1"},
+				},
+			},
+			policies: []*policyv1a1.PropagationPolicy{
+				namespaced("p1", newPolicy(0, "FederatedDeployment", nil, "cluster2")),
+			},
+			expectedClusters: sets.NewString("cluster1"),
+		},
+		"explicit cluster selector takes precedence over policy": {
+			placement: map[string]interface{}{
+				ClusterSelectorField: map[string]interface{}{
+					MatchLabelsField: map[string]interface{}{"tier": "prod"},
+				},
+			},
+			policies: []*policyv1a1.PropagationPolicy{
+				namespaced("p1", newPolicy(0, "FederatedDeployment", nil, "cluster2")),
+			},
+			expectedClusters: sets.NewString("cluster1"),
+		},
+		"other placement fields of the resource are retained": {
+			placement: map[string]interface{}{
+				MaxClustersField: int64(1),
+			},
+			policies: []*policyv1a1.PropagationPolicy{
+				namespaced("p1", newPolicy(0, "FederatedDeployment", nil, "cluster2", "cluster3")),
+			},
+			expectedPolicy: &PolicyReference{Kind: PropagationPolicyKind, Namespace: "ns", Name: "p1"},
+		},
+	}
+
+	for testName, tc := range testCases {
+		t.Run(testName, func(t *testing.T) {
+			obj := &unstructured.Unstructured{
+				Object: map[string]interface{}{
+					"kind": "FederatedDeployment",
+					"metadata": map[string]interface{}{
+						"name":      "foo",
+						"namespace": "ns",
+						"labels": map[string]interface{}{
+							"app": "foo",
+						},
+					},
+					"spec": make(map[string]interface{}),
+				},
+			}
+			if tc.placement != nil {
+				if err := unstructured.SetNestedMap(obj.Object, tc.placement, SpecField, PlacementField); err != nil {
+					t.Fatalf("Unexpected error: %v", err)
+				}
+			}
+			original := obj.DeepCopy()
+
+			defaulted, ref, err := ApplyPropagationPolicy(obj, tc.policies, tc.clusterPolicies)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if !reflect.DeepEqual(ref, tc.expectedPolicy) {
+				t.Fatalf("Expected policy %v, got %v", tc.expectedPolicy, ref)
+			}
+			if !reflect.DeepEqual(obj, original) {
+				t.Fatalf("Expected the resource to be unmodified")
+			}
+			selectedNames, err := ComputePlacement(defaulted, clusters, false)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if tc.expectedClusters == nil {
+				if selectedNames.Len() != 1 || !sets.NewString("cluster2", "cluster3").IsSuperset(selectedNames) {
+					t.Fatalf("Expected one of the policy clusters to be selected, got %v", selectedNames)
+				}
+				return
+			}
+			if !reflect.DeepEqual(selectedNames, tc.expectedClusters) {
+				t.Fatalf("Expected clusters %v, got %v", tc.expectedClusters, selectedNames)
+			}
+		})
+	}
+}
@@ -0,0 +1,45 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package util
+
+import (
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	fedv1b1 "sigs.k8s.io/kubefed/pkg/apis/core/v1beta1"
+)
+
+const (
+	// If this annotation is present on a federated resource, its value
+	// determines whether the sync controller updates resources in member
+	// clusters that differ from the desired state ("Enforce") or only
+	// reports the difference ("ReportOnly"). The annotation takes
+	// precedence over the reconcile mode of the FederatedTypeConfig.
+	ReconcileModeAnnotation = "kubefed.io/reconcile-mode"
+)
+
+// IsReportOnly checks whether differences of resources in member
+// clusters from the desired state of the given federated resource
+// should only be reported, taking into account the reconcile mode of
+// the resource's type.
+func IsReportOnly(obj *unstructured.Unstructured, typeMode fedv1b1.ReconcileMode) bool {
+	mode := typeMode
+	switch value := fedv1b1.ReconcileMode(obj.GetAnnotations()[ReconcileModeAnnotation]); value {
+	case fedv1b1.ReconcileEnforce, fedv1b1.ReconcileReportOnly:
+		mode = value
+	}
+	return mode == fedv1b1.ReconcileReportOnly
+}
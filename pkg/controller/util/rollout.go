@@ -0,0 +1,130 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package util
+
+import (
+	"github.com/pkg/errors"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/apimachinery/pkg/util/sets"
+)
+
+// GenericRolloutStrategy determines the order in which changes to a
+// federated resource are propagated to its selected clusters.
+type GenericRolloutStrategy struct {
+	// CanaryClusters are updated before any other cluster.
+	CanaryClusters []GenericClusterReference `json:"canaryClusters,omitempty"`
+	// BatchSize is the number (e.g. 2) or percentage (e.g. "25%") of
+	// the selected clusters that are updated together once the
+	// canary clusters are healthy. Defaults to 1.
+	BatchSize *intstr.IntOrString `json:"batchSize,omitempty"`
+}
+
+type GenericRolloutSpec struct {
+	RolloutStrategy *GenericRolloutStrategy `json:"rolloutStrategy,omitempty"`
+}
+
+type GenericRollout struct {
+	Spec GenericRolloutSpec `json:"spec,omitempty"`
+}
+
+// GetRolloutStrategy returns the rollout strategy of the given
+// federated resource, or nil if changes should be propagated to all
+// selected clusters at once.
+func GetRolloutStrategy(obj *unstructured.Unstructured) (*GenericRolloutStrategy, error) {
+	rollout := &GenericRollout{}
+	err := UnstructuredToInterface(obj, rollout)
+	if err != nil {
+		return nil, err
+	}
+	strategy := rollout.Spec.RolloutStrategy
+	if strategy == nil {
+		return nil, nil
+	}
+	if strategy.BatchSize != nil {
+		if _, err := intstr.GetScaledValueFromIntOrPercent(strategy.BatchSize, 100, true); err != nil {
+			return nil, errors.Wrap(err, "invalid batch size")
+		}
+		if strategy.BatchSize.Type == intstr.Int && strategy.BatchSize.IntVal < 1 {
+			return nil, errors.Errorf("batch size must be at least 1, got %d", strategy.BatchSize.IntVal)
+		}
+	}
+	return strategy, nil
+}
+
+// Batches divides the given selected clusters into the batches they
+// are updated in. The selected canary clusters form the first batch
+// in the order they are listed, followed by batches of the remaining
+// clusters in name order.
+func (s *GenericRolloutStrategy) Batches(selectedClusters sets.String) [][]string {
+	var batches [][]string
+
+	remaining := sets.NewString(selectedClusters.UnsortedList()...)
+	var canaries []string
+	for _, cluster := range s.CanaryClusters {
+		if remaining.Has(cluster.Name) {
+			canaries = append(canaries, cluster.Name)
+			remaining.Delete(cluster.Name)
+		}
+	}
+	if len(canaries) > 0 {
+		batches = append(batches, canaries)
+	}
+
+	batchSize := 1
+	if s.BatchSize != nil {
+		// The batch size has been validated by GetRolloutStrategy.
+		batchSize, _ = intstr.GetScaledValueFromIntOrPercent(s.BatchSize, selectedClusters.Len(), true)
+		if batchSize < 1 {
+			batchSize = 1
+		}
+	}
+	clusterNames := remaining.List()
+	for len(clusterNames) > 0 {
+		size := batchSize
+		if size > len(clusterNames) {
+			size = len(clusterNames)
+		}
+		batches = append(batches, clusterNames[:size])
+		clusterNames = clusterNames[size:]
+	}
+	return batches
+}
+
+// RolloutHealth indicates whether a resource updated in a member
+// cluster as part of a rollout allows the rollout to proceed.
+type RolloutHealth string
+
+const (
+	RolloutHealthy     RolloutHealth = "Healthy"
+	RolloutProgressing RolloutHealth = "Progressing"
+	RolloutFailed      RolloutHealth = "Failed"
+)
+
+// RolloutHealthFor returns the rollout health of a resource in a
+// member cluster with the given health. A degraded resource fails the
+// rollout and a progressing resource holds it back.
+func RolloutHealthFor(health ResourceHealth) RolloutHealth {
+	switch health {
+	case ResourceDegraded:
+		return RolloutFailed
+	case ResourceProgressing:
+		return RolloutProgressing
+	}
+	return RolloutHealthy
+}
@@ -0,0 +1,152 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package util
+
+import (
+	"reflect"
+	"testing"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/apimachinery/pkg/util/sets"
+)
+
+func TestRolloutBatches(t *testing.T) {
+	selectedClusters := sets.NewString("c1", "c2", "c3", "c4", "c5")
+	testCases := map[string]struct {
+		canaries        []string
+		batchSize       *intstr.IntOrString
+		expectedBatches [][]string
+	}{
+		"Clusters are updated one at a time by default": {
+			expectedBatches: [][]string{{"c1"}, {"c2"}, {"c3"}, {"c4"}, {"c5"}},
+		},
+		"Canaries are updated first in the listed order": {
+			canaries:        []string{"c4", "c2", "unselected"},
+			batchSize:       intstrPtr(intstr.FromInt(2)),
+			expectedBatches: [][]string{{"c4", "c2"}, {"c1", "c3"}, {"c5"}},
+		},
+		"Percentage batch size is rounded up": {
+			batchSize:       intstrPtr(intstr.FromString("50%")),
+			expectedBatches: [][]string{{"c1", "c2", "c3"}, {"c4", "c5"}},
+		},
+		"Percentage batch size is at least one cluster": {
+			batchSize:       intstrPtr(intstr.FromString("0%")),
+			expectedBatches: [][]string{{"c1"}, {"c2"}, {"c3"}, {"c4"}, {"c5"}},
+		},
+	}
+	for testName, tc := range testCases {
+		t.Run(testName, func(t *testing.T) {
+			strategy := &GenericRolloutStrategy{BatchSize: tc.batchSize}
+			for _, name := range tc.canaries {
+				strategy.CanaryClusters = append(strategy.CanaryClusters, GenericClusterReference{Name: name})
+			}
+			batches := strategy.Batches(selectedClusters)
+			if !reflect.DeepEqual(batches, tc.expectedBatches) {
+				t.Fatalf("Expected batches %v, got %v", tc.expectedBatches, batches)
+			}
+		})
+	}
+}
+
+func TestGetRolloutStrategy(t *testing.T) {
+	testCases := map[string]struct {
+		batchSize   interface{}
+		expectedErr bool
+	}{
+		"Integer batch size is valid": {
+			batchSize: int64(2),
+		},
+		"Percentage batch size is valid": {
+			batchSize: "25%",
+		},
+		"Zero batch size is invalid": {
+			batchSize:   int64(0),
+			expectedErr: true,
+		},
+		"Malformed percentage is invalid": {
+			batchSize:   "quarter",
+			expectedErr: true,
+		},
+	}
+	for testName, tc := range testCases {
+		t.Run(testName, func(t *testing.T) {
+			obj := &unstructured.Unstructured{
+				Object: map[string]interface{}{
+					"spec": map[string]interface{}{
+						"rolloutStrategy": map[string]interface{}{
+							"batchSize": tc.batchSize,
+						},
+					},
+				},
+			}
+			_, err := GetRolloutStrategy(obj)
+			if tc.expectedErr && err == nil {
+				t.Fatalf("Expected an error")
+			}
+			if !tc.expectedErr && err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+		})
+	}
+}
+
+func TestRolloutHealthFor(t *testing.T) {
+	for health, expected := range map[ResourceHealth]RolloutHealth{
+		ResourceHealthy:     RolloutHealthy,
+		ResourceProgressing: RolloutProgressing,
+		ResourceDegraded:    RolloutFailed,
+	} {
+		if rolloutHealth := RolloutHealthFor(health); rolloutHealth != expected {
+			t.Errorf("Expected rollout health %q for health %q, got %q", expected, health, rolloutHealth)
+		}
+	}
+}
+
+func deploymentObject(replicas, statusReplicas, updated, ready, available int64, progressingReason string) map[string]interface{} {
+	status := map[string]interface{}{
+		"observedGeneration": int64(1),
+		"replicas":           statusReplicas,
+		"updatedReplicas":    updated,
+		"readyReplicas":      ready,
+		"availableReplicas":  available,
+	}
+	if progressingReason != "" {
+		status["conditions"] = []interface{}{
+			map[string]interface{}{
+				"type":   "Progressing",
+				"status": "False",
+				"reason": progressingReason,
+			},
+		}
+	}
+	return map[string]interface{}{
+		"apiVersion": "apps/v1",
+		"kind":       "Deployment",
+		"metadata": map[string]interface{}{
+			"generation": int64(1),
+		},
+		"spec": map[string]interface{}{
+			"replicas": replicas,
+		},
+		"status": status,
+	}
+}
+
+func intstrPtr(value intstr.IntOrString) *intstr.IntOrString {
+	return &value
+}
@@ -0,0 +1,115 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package federatedresource
+
+import (
+	"fmt"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	"sigs.k8s.io/kubefed/pkg/apis/core/typeconfig"
+	fedv1b1 "sigs.k8s.io/kubefed/pkg/apis/core/v1beta1"
+	"sigs.k8s.io/kubefed/pkg/controller/util"
+)
+
+// ValidateFederatedResource checks that the placement cluster
+// selector, the rollout strategy, the dependencies and the overrides
+// of a federated resource of the given type are well-formed, and that
+// the overrides for each named cluster apply to the template. The
+// given clusters determine the overrides with a cluster selector that
+// apply to a named cluster.
+func ValidateFederatedResource(obj *unstructured.Unstructured, typeConfig typeconfig.Interface, clusters []*fedv1b1.KubeFedCluster) field.ErrorList {
+	allErrs := field.ErrorList{}
+	specPath := field.NewPath(util.SpecField)
+
+	selectorPath := specPath.Child(util.PlacementField, util.ClusterSelectorField)
+	rawSelector, ok, err := unstructured.NestedMap(obj.Object, util.SpecField, util.PlacementField, util.ClusterSelectorField)
+	if err != nil {
+		allErrs = append(allErrs, field.Invalid(selectorPath, nil, err.Error()))
+	} else if ok {
+		selector := &metav1.LabelSelector{}
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(rawSelector, selector); err != nil {
+			allErrs = append(allErrs, field.Invalid(selectorPath, rawSelector, err.Error()))
+		} else if _, err := metav1.LabelSelectorAsSelector(selector); err != nil {
+			allErrs = append(allErrs, field.Invalid(selectorPath, nil, err.Error()))
+		}
+	}
+
+	if _, err := util.GetRolloutStrategy(obj); err != nil {
+		allErrs = append(allErrs, field.Invalid(specPath.Child(util.RolloutStrategyField), nil, err.Error()))
+	}
+
+	if _, err := util.GetDependencies(obj); err != nil {
+		allErrs = append(allErrs, field.Invalid(specPath.Child(util.DependsOnField), nil, err.Error()))
+	}
+
+	template, ok, err := unstructured.NestedMap(obj.Object, util.SpecField, util.TemplateField)
+	if err != nil {
+		allErrs = append(allErrs, field.Invalid(specPath.Child(util.TemplateField), nil, err.Error()))
+		return allErrs
+	}
+	if !ok {
+		template = make(map[string]interface{})
+	}
+	templateObj := objectForTemplate(obj, template, typeConfig)
+	if err := util.ValidateOverrides(obj, templateObj, clusters); err != nil {
+		allErrs = append(allErrs, field.Forbidden(specPath.Child(util.OverridesField), err.Error()))
+	}
+
+	return allErrs
+}
+
+// ValidateDependencies checks that the given federated resource does
+// not depend on itself, either directly or through the dependencies
+// of the federated resources it depends on, which are retrieved with
+// the given function.
+func ValidateDependencies(obj *unstructured.Unstructured, getDependency util.GetDependencyFunc) field.ErrorList {
+	allErrs := field.ErrorList{}
+	dependsOnPath := field.NewPath(util.SpecField, util.DependsOnField)
+	cycle, err := util.FindDependencyCycle(obj, getDependency)
+	switch {
+	case err != nil:
+		allErrs = append(allErrs, field.InternalError(dependsOnPath, err))
+	case cycle != nil:
+		allErrs = append(allErrs, field.Forbidden(dependsOnPath, fmt.Sprintf("dependencies form a cycle: %s", util.DependencyChainString(obj, cycle))))
+	}
+	return allErrs
+}
+
+// objectForTemplate returns the object the sync controller creates
+// from the given template of a federated resource before applying
+// the overrides for a cluster.
+func objectForTemplate(fedObject *unstructured.Unstructured, template map[string]interface{}, typeConfig typeconfig.Interface) *unstructured.Unstructured {
+	obj := &unstructured.Unstructured{Object: template}
+	// Annotations and finalizers of the template are not propagated
+	obj.SetAnnotations(nil)
+	obj.SetFinalizers(nil)
+
+	targetType := typeConfig.GetTargetType()
+	obj.SetName(fedObject.GetName())
+	if targetType.Kind != util.NamespaceKind {
+		obj.SetNamespace(fedObject.GetNamespace())
+	}
+	obj.SetKind(targetType.Kind)
+	if len(obj.GetAPIVersion()) == 0 {
+		obj.SetAPIVersion(fmt.Sprintf("%s/%s", targetType.Group, targetType.Version))
+	}
+	return obj
+}
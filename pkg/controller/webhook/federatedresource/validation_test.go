@@ -0,0 +1,192 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package federatedresource
+
+import (
+	"strings"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"sigs.k8s.io/kubefed/pkg/apis/core/v1beta1"
+	kfenable "sigs.k8s.io/kubefed/pkg/kubefedctl/enable"
+)
+
+func TestValidateFederatedResource(t *testing.T) {
+	typeConfig := &v1beta1.FederatedTypeConfig{
+		Spec: v1beta1.FederatedTypeConfigSpec{
+			TargetType: v1beta1.APIResource{
+				Group:   "apps",
+				Version: "v1",
+				Kind:    "Deployment",
+			},
+		},
+	}
+	clusters := []*v1beta1.KubeFedCluster{
+		{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:   "cluster1",
+				Labels: map[string]string{"tier": "gold"},
+			},
+		},
+	}
+	testCases := map[string]struct {
+		spec             string
+		expectedErrorMsg string
+	}{
+		"Valid placement": {
+			spec: `
+placement:
+  clusterSelector:
+    matchExpressions:
+    - key: region
+      operator: In
+      values: [us, eu]
+`,
+		},
+		"Invalid placement": {
+			spec: `
+placement:
+  clusterSelector:
+    matchExpressions:
+    - key: region
+      operator: Foo
+`,
+			expectedErrorMsg: `spec.placement.clusterSelector: Invalid value: "null": "Foo" is not a valid pod selector operator`,
+		},
+		"Named override depending on a selector override": {
+			spec: `
+template:
+  spec:
+    replicas: 1
+overrides:
+- clusterSelector:
+    matchLabels:
+      tier: gold
+  clusterOverrides:
+  - op: add
+    path: /spec/strategy
+    value:
+      type: RollingUpdate
+- clusterName: cluster1
+  clusterOverrides:
+  - op: replace
+    path: /spec/strategy/type
+    value: Recreate
+`,
+		},
+		"Named override that does not apply": {
+			spec: `
+template:
+  spec:
+    replicas: 1
+overrides:
+- clusterSelector:
+    matchLabels:
+      tier: silver
+  clusterOverrides:
+  - op: add
+    path: /spec/strategy
+    value:
+      type: RollingUpdate
+- clusterName: cluster1
+  clusterOverrides:
+  - op: replace
+    path: /spec/strategy/type
+    value: Recreate
+`,
+			expectedErrorMsg: `spec.overrides: Forbidden: overrides for cluster "cluster1" do not apply to the template: ` +
+				`failed to apply replace override for path "/spec/strategy/type": replace operation does not apply: doc is missing path: /spec/strategy/type: missing value`,
+		},
+		"Named override of the name and namespace": {
+			spec: `
+overrides:
+- clusterName: cluster2
+  clusterOverrides:
+  - op: test
+    path: /metadata
+    value:
+      name: foo
+      namespace: bar
+`,
+		},
+		"Templated value": {
+			spec: `
+template:
+  spec:
+    replicas: 1
+overrides:
+- clusterSelector: {}
+  clusterOverrides:
+  - path: /spec/replicas
+    value: "{{ .Cluster.Labels.replicas }}"
+`,
+		},
+		"Templated value that does not parse": {
+			spec: `
+overrides:
+- clusterName: cluster1
+  clusterOverrides:
+  - path: /spec/replicas
+    value: "{{ .Cluster.Labels.replicas"
+`,
+			expectedErrorMsg: `spec.overrides: Forbidden: overrides[0] is invalid: invalid value for path "/spec/replicas": template: override:1: unclosed action`,
+		},
+	}
+	for testName, tc := range testCases {
+		t.Run(testName, func(t *testing.T) {
+			obj := newFederatedResource(t, tc.spec)
+			errs := ValidateFederatedResource(obj, typeConfig, clusters)
+			if len(tc.expectedErrorMsg) > 0 {
+				if len(errs) == 0 || errs.ToAggregate().Error() != tc.expectedErrorMsg {
+					t.Fatalf("Expected error %q, got %v", tc.expectedErrorMsg, errs.ToAggregate())
+				}
+				return
+			}
+			if len(errs) != 0 {
+				t.Fatalf("Unexpected error: %v", errs.ToAggregate())
+			}
+		})
+	}
+}
+
+func newFederatedResource(t *testing.T, spec string) *unstructured.Unstructured {
+	yaml := `
+apiVersion: types.kubefed.io/v1beta1
+kind: FederatedDeployment
+metadata:
+  name: foo
+  namespace: bar
+spec:
+` + indent(spec)
+	obj := &unstructured.Unstructured{}
+	if err := kfenable.DecodeYAML(strings.NewReader(yaml), obj); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	return obj
+}
+
+func indent(s string) string {
+	lines := strings.Split(strings.TrimPrefix(s, "\n"), "\n")
+	for i, line := range lines {
+		if line != "" {
+			lines[i] = "  " + line
+		}
+	}
+	return strings.Join(lines, "\n")
+}
@@ -0,0 +1,210 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package federatedresource
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"reflect"
+
+	admissionv1 "k8s.io/api/admission/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/klog/v2"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	"sigs.k8s.io/kubefed/pkg/apis/core/v1beta1"
+	"sigs.k8s.io/kubefed/pkg/controller/util"
+	"sigs.k8s.io/kubefed/pkg/controller/webhook"
+)
+
+const (
+	ResourceName = "FederatedResource"
+)
+
+// FederatedResourceAdmissionHook validates the placement and
+// overrides of the resources of any federated type configured by a
+// FederatedTypeConfig. Requests for other resources are allowed.
+type FederatedResourceAdmissionHook struct {
+	// Client is used to read the FederatedTypeConfigs and
+	// KubeFedClusters in the KubeFed system namespace.
+	Client client.Reader
+	// APIReader is used to read the dependencies of federated
+	// resources, which may be in any namespace, without caching them.
+	APIReader        client.Reader
+	KubeFedNamespace string
+}
+
+var _ admission.Handler = &FederatedResourceAdmissionHook{}
+
+func (a *FederatedResourceAdmissionHook) Handle(ctx context.Context, admissionSpec admission.Request) admission.Response {
+	klog.V(4).Infof("Validating %q AdmissionRequest = %s", ResourceName, webhook.AdmissionRequestDebugString(admissionSpec))
+
+	// We want to let through:
+	// - Requests that are not for create, update
+	// - Requests for subresources (e.g. status)
+	// - Requests for things that are not federated resources
+	createOrUpdate := admissionSpec.Operation == admissionv1.Create || admissionSpec.Operation == admissionv1.Update
+	if !createOrUpdate || len(admissionSpec.SubResource) != 0 {
+		return allowed()
+	}
+	typeConfigs, err := a.typeConfigs(ctx)
+	if err != nil {
+		return internalError(err)
+	}
+	typeConfig := typeConfigForResource(typeConfigs, admissionSpec.Resource)
+	if typeConfig == nil {
+		return allowed()
+	}
+
+	admittingObject := &unstructured.Unstructured{}
+	if err := json.Unmarshal(admissionSpec.Object.Raw, &admittingObject.Object); err != nil {
+		return admission.Response{
+			AdmissionResponse: admissionv1.AdmissionResponse{
+				Allowed: false,
+				Result: &metav1.Status{
+					Status: metav1.StatusFailure, Code: http.StatusBadRequest, Reason: metav1.StatusReasonBadRequest,
+					Message: err.Error(),
+				},
+			},
+		}
+	}
+
+	// Requests that do not change the spec (e.g. the removal of a
+	// finalizer or an update of labels) and requests for resources
+	// being deleted are allowed so that a resource whose spec is
+	// invalid (e.g. one created before the webhook was enabled) can
+	// still be deleted and have its metadata updated.
+	if admittingObject.GetDeletionTimestamp() != nil {
+		return allowed()
+	}
+	if admissionSpec.Operation == admissionv1.Update {
+		oldObject := &unstructured.Unstructured{}
+		if err := json.Unmarshal(admissionSpec.OldObject.Raw, &oldObject.Object); err == nil &&
+			reflect.DeepEqual(oldObject.Object[util.SpecField], admittingObject.Object[util.SpecField]) {
+			return allowed()
+		}
+	}
+
+	clusters, err := a.clusters(ctx)
+	if err != nil {
+		return internalError(err)
+	}
+
+	klog.V(4).Infof("Validating %s %q", typeConfig.GetFederatedType().Kind, admissionSpec.Name)
+
+	return webhook.Validate(func() field.ErrorList {
+		allErrs := ValidateFederatedResource(admittingObject, typeConfig, clusters)
+		if len(allErrs) > 0 {
+			return allErrs
+		}
+		return ValidateDependencies(admittingObject, a.dependencyGetter(ctx, typeConfigs))
+	})
+}
+
+// typeConfigs returns the FederatedTypeConfigs in the KubeFed system
+// namespace.
+func (a *FederatedResourceAdmissionHook) typeConfigs(ctx context.Context) ([]v1beta1.FederatedTypeConfig, error) {
+	typeConfigList := &v1beta1.FederatedTypeConfigList{}
+	if err := a.Client.List(ctx, typeConfigList, client.InNamespace(a.KubeFedNamespace)); err != nil {
+		return nil, err
+	}
+	return typeConfigList.Items, nil
+}
+
+// typeConfigForResource returns the FederatedTypeConfig whose
+// federated type is the given resource, or nil if there is none.
+func typeConfigForResource(typeConfigs []v1beta1.FederatedTypeConfig, resource metav1.GroupVersionResource) *v1beta1.FederatedTypeConfig {
+	for i := range typeConfigs {
+		typeConfig := &typeConfigs[i]
+		federatedType := typeConfig.GetFederatedType()
+		if federatedType.Group == resource.Group && federatedType.Name == resource.Resource {
+			return typeConfig
+		}
+	}
+	return nil
+}
+
+// dependencyGetter returns a function that retrieves the dependencies
+// of federated resources from the API. As in the sync controller, a
+// dependency whose kind is not the federated type of a
+// FederatedTypeConfig is treated as not existing.
+func (a *FederatedResourceAdmissionHook) dependencyGetter(ctx context.Context, typeConfigs []v1beta1.FederatedTypeConfig) util.GetDependencyFunc {
+	return func(dependent *unstructured.Unstructured, dependency util.GenericDependency) (*unstructured.Unstructured, error) {
+		groupKind := dependency.GroupVersionKind(dependent).GroupKind()
+		for i := range typeConfigs {
+			federatedType := typeConfigs[i].GetFederatedType()
+			if federatedType.Group != groupKind.Group || federatedType.Kind != groupKind.Kind {
+				continue
+			}
+			key := client.ObjectKey{Name: dependency.Name}
+			if typeConfigs[i].GetFederatedNamespaced() {
+				key.Namespace = dependent.GetNamespace()
+			}
+			obj := &unstructured.Unstructured{}
+			obj.SetGroupVersionKind(schema.GroupVersionKind{Group: federatedType.Group, Version: federatedType.Version, Kind: federatedType.Kind})
+			err := a.APIReader.Get(ctx, key, obj)
+			if apierrors.IsNotFound(err) {
+				return nil, nil
+			}
+			if err != nil {
+				return nil, err
+			}
+			return obj, nil
+		}
+		return nil, nil
+	}
+}
+
+// clusters returns the KubeFedClusters registered in the KubeFed
+// system namespace.
+func (a *FederatedResourceAdmissionHook) clusters(ctx context.Context) ([]*v1beta1.KubeFedCluster, error) {
+	clusterList := &v1beta1.KubeFedClusterList{}
+	if err := a.Client.List(ctx, clusterList, client.InNamespace(a.KubeFedNamespace)); err != nil {
+		return nil, err
+	}
+	clusters := make([]*v1beta1.KubeFedCluster, 0, len(clusterList.Items))
+	for i := range clusterList.Items {
+		clusters = append(clusters, &clusterList.Items[i])
+	}
+	return clusters, nil
+}
+
+func internalError(err error) admission.Response {
+	return admission.Response{
+		AdmissionResponse: admissionv1.AdmissionResponse{
+			Allowed: false,
+			Result: &metav1.Status{
+				Status: metav1.StatusFailure, Code: http.StatusInternalServerError, Reason: metav1.StatusReasonInternalError,
+				Message: err.Error(),
+			},
+		},
+	}
+}
+
+func allowed() admission.Response {
+	return admission.Response{
+		AdmissionResponse: admissionv1.AdmissionResponse{
+			Allowed: true,
+		},
+	}
+}
@@ -0,0 +1,202 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package federatedresource
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	admissionv1 "k8s.io/api/admission/v1"
+	apiextv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/pointer"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	"sigs.k8s.io/kubefed/pkg/apis/core/v1beta1"
+)
+
+func TestHandle(t *testing.T) {
+	validSpec := `
+template:
+  spec:
+    replicas: 1
+overrides:
+- clusterName: cluster1
+  clusterOverrides:
+  - path: /spec/replicas
+    value: 2
+`
+	invalidSpec := `
+overrides:
+- clusterName: cluster1
+  clusterOverrides:
+  - op: merge
+    path: /spec/replicas
+`
+	selfReferenceSpec := `
+dependsOn:
+- kind: FederatedDeployment
+  name: foo
+`
+	cycleSpec := `
+dependsOn:
+- kind: FederatedConfigMap
+  name: config
+`
+	labeled := func(obj *unstructured.Unstructured) *unstructured.Unstructured {
+		obj.SetLabels(map[string]string{"foo": "bar"})
+		return obj
+	}
+	deleting := func(obj *unstructured.Unstructured) *unstructured.Unstructured {
+		now := metav1.Now()
+		obj.SetDeletionTimestamp(&now)
+		return obj
+	}
+
+	testCases := map[string]struct {
+		operation       admissionv1.Operation
+		obj             *unstructured.Unstructured
+		oldObj          *unstructured.Unstructured
+		expectedAllowed bool
+	}{
+		"Create of a valid resource is allowed": {
+			operation:       admissionv1.Create,
+			obj:             newFederatedResource(t, validSpec),
+			expectedAllowed: true,
+		},
+		"Create of an invalid resource is rejected": {
+			operation: admissionv1.Create,
+			obj:       newFederatedResource(t, invalidSpec),
+		},
+		"Create of a resource depending on itself is rejected": {
+			operation: admissionv1.Create,
+			obj:       newFederatedResource(t, selfReferenceSpec),
+		},
+		"Create of a resource whose dependencies depend on it is rejected": {
+			operation: admissionv1.Create,
+			obj:       newFederatedResource(t, cycleSpec),
+		},
+		"Update of the spec of an invalid resource is rejected": {
+			operation: admissionv1.Update,
+			obj:       newFederatedResource(t, invalidSpec),
+			oldObj:    newFederatedResource(t, validSpec),
+		},
+		"Update of the metadata of an invalid resource is allowed": {
+			operation:       admissionv1.Update,
+			obj:             labeled(newFederatedResource(t, invalidSpec)),
+			oldObj:          newFederatedResource(t, invalidSpec),
+			expectedAllowed: true,
+		},
+		"Update of an invalid resource being deleted is allowed": {
+			operation:       admissionv1.Update,
+			obj:             deleting(newFederatedResource(t, invalidSpec)),
+			oldObj:          newFederatedResource(t, validSpec),
+			expectedAllowed: true,
+		},
+	}
+
+	scheme := runtime.NewScheme()
+	if err := v1beta1.AddToScheme(scheme); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	typeConfig := &v1beta1.FederatedTypeConfig{
+		ObjectMeta: metav1.ObjectMeta{Name: "deployments.apps", Namespace: "kube-federation-system"},
+		Spec: v1beta1.FederatedTypeConfigSpec{
+			TargetType: v1beta1.APIResource{
+				Group:   "apps",
+				Version: "v1",
+				Kind:    "Deployment",
+				Scope:   apiextv1.NamespaceScoped,
+			},
+			FederatedType: v1beta1.APIResource{
+				Group:      "types.kubefed.io",
+				Version:    "v1beta1",
+				Kind:       "FederatedDeployment",
+				PluralName: "federateddeployments",
+			},
+		},
+	}
+	configMapTypeConfig := &v1beta1.FederatedTypeConfig{
+		ObjectMeta: metav1.ObjectMeta{Name: "configmaps", Namespace: "kube-federation-system"},
+		Spec: v1beta1.FederatedTypeConfigSpec{
+			TargetType: v1beta1.APIResource{
+				Version: "v1",
+				Kind:    "ConfigMap",
+				Scope:   apiextv1.NamespaceScoped,
+			},
+			FederatedType: v1beta1.APIResource{
+				Group:      "types.kubefed.io",
+				Version:    "v1beta1",
+				Kind:       "FederatedConfigMap",
+				PluralName: "federatedconfigmaps",
+			},
+		},
+	}
+	configMap := &unstructured.Unstructured{}
+	configMap.SetAPIVersion("types.kubefed.io/v1beta1")
+	configMap.SetKind("FederatedConfigMap")
+	configMap.SetNamespace("bar")
+	configMap.SetName("config")
+	configMap.Object["spec"] = map[string]interface{}{
+		"dependsOn": []interface{}{
+			map[string]interface{}{"kind": "FederatedDeployment", "name": "foo"},
+		},
+	}
+	client := fake.NewClientBuilder().WithScheme(scheme).WithObjects(typeConfig, configMapTypeConfig, configMap).Build()
+	hook := &FederatedResourceAdmissionHook{
+		Client:           client,
+		APIReader:        client,
+		KubeFedNamespace: "kube-federation-system",
+	}
+
+	for testName, tc := range testCases {
+		t.Run(testName, func(t *testing.T) {
+			request := admission.Request{
+				AdmissionRequest: admissionv1.AdmissionRequest{
+					Operation: tc.operation,
+					Resource:  metav1.GroupVersionResource{Group: "types.kubefed.io", Version: "v1beta1", Resource: "federateddeployments"},
+					Name:      tc.obj.GetName(),
+					Namespace: tc.obj.GetNamespace(),
+					Object:    rawExtension(t, tc.obj),
+					DryRun:    pointer.BoolPtr(false),
+				},
+			}
+			if tc.oldObj != nil {
+				request.OldObject = rawExtension(t, tc.oldObj)
+			}
+			response := hook.Handle(context.TODO(), request)
+			if response.Allowed != tc.expectedAllowed {
+				t.Fatalf("Expected allowed to be %v, got %v: %v", tc.expectedAllowed, response.Allowed, response.Result)
+			}
+			if !response.Allowed && response.Result.Code != http.StatusForbidden {
+				t.Fatalf("Expected the request to be forbidden, got %v", response.Result)
+			}
+		})
+	}
+}
+
+func rawExtension(t *testing.T, obj *unstructured.Unstructured) runtime.RawExtension {
+	raw, err := obj.MarshalJSON()
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	return runtime.RawExtension{Raw: raw}
+}
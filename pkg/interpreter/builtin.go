@@ -0,0 +1,264 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package interpreter
+
+import (
+	"reflect"
+
+	"github.com/pkg/errors"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"sigs.k8s.io/kubefed/pkg/controller/util"
+)
+
+// workloadReplicaFields are the status fields of workloads that are
+// summed up by the aggregated status.
+var workloadReplicaFields = []string{"replicas", "readyReplicas", "availableReplicas", "updatedReplicas"}
+
+// defaultInterpreter interprets resources of kinds without a
+// built-in interpreter. No fields are retained, replicas are
+// specified by spec.replicas and reported by status.readyReplicas,
+// and the status of resources is collected as is but not aggregated.
+type defaultInterpreter struct{}
+
+func (defaultInterpreter) Retain(desiredObj, clusterObj *unstructured.Unstructured) error {
+	return nil
+}
+
+func (defaultInterpreter) Health(clusterObj *unstructured.Unstructured) (util.ResourceHealth, error) {
+	return util.GetResourceHealth(clusterObj)
+}
+
+func (defaultInterpreter) GetReplicas(obj *unstructured.Unstructured) (int64, int64, bool, error) {
+	replicas, found, err := unstructured.NestedInt64(obj.Object, util.SpecField, util.ReplicasField)
+	if err != nil {
+		return 0, 0, false, errors.Wrap(err, "Error retrieving 'replicas' field")
+	}
+	if !found {
+		return 0, 0, false, nil
+	}
+	readyReplicas, _, err := unstructured.NestedInt64(obj.Object, util.StatusField, "readyReplicas")
+	if err != nil {
+		return 0, 0, false, errors.Wrap(err, "Error retrieving 'readyReplicas' field")
+	}
+	return replicas, readyReplicas, true, nil
+}
+
+func (defaultInterpreter) SetReplicas(obj *unstructured.Unstructured, replicas int64) error {
+	return unstructured.SetNestedField(obj.Object, replicas, util.SpecField, util.ReplicasField)
+}
+
+func (defaultInterpreter) ReflectStatus(clusterObj *unstructured.Unstructured) (map[string]interface{}, error) {
+	status, _, err := unstructured.NestedMap(clusterObj.Object, util.StatusField)
+	if err != nil {
+		return nil, errors.Wrap(err, "Error retrieving status")
+	}
+	return status, nil
+}
+
+func (defaultInterpreter) AggregateStatus(clusterStatuses []util.ResourceClusterStatus) (map[string]interface{}, error) {
+	return nil, nil
+}
+
+// workloadInterpreter interprets workloads whose status reports the
+// number of their replicas. The aggregated status sums up the
+// replicas reported by member clusters.
+type workloadInterpreter struct {
+	defaultInterpreter
+}
+
+func (workloadInterpreter) AggregateStatus(clusterStatuses []util.ResourceClusterStatus) (map[string]interface{}, error) {
+	if len(clusterStatuses) == 0 {
+		return nil, nil
+	}
+	aggregated := make(map[string]interface{})
+	for _, field := range workloadReplicaFields {
+		var sum int64
+		for _, clusterStatus := range clusterStatuses {
+			value, _, err := unstructured.NestedInt64(clusterStatus.Status, field)
+			if err != nil {
+				return nil, errors.Wrapf(err, "Error retrieving %q from the status of cluster %q", field, clusterStatus.ClusterName)
+			}
+			sum += value
+		}
+		aggregated[field] = sum
+	}
+	return aggregated, nil
+}
+
+// serviceInterpreter interprets services. The fields allocated by
+// member clusters are retained, and the aggregated status lists the
+// load balancer ingress points of all member clusters.
+type serviceInterpreter struct {
+	defaultInterpreter
+}
+
+func (serviceInterpreter) Retain(desiredObj, clusterObj *unstructured.Unstructured) error {
+	return retainServiceFields(desiredObj, clusterObj)
+}
+
+func (serviceInterpreter) AggregateStatus(clusterStatuses []util.ResourceClusterStatus) (map[string]interface{}, error) {
+	var ingress []interface{}
+	for _, clusterStatus := range clusterStatuses {
+		clusterIngress, _, err := unstructured.NestedSlice(clusterStatus.Status, "loadBalancer", "ingress")
+		if err != nil {
+			return nil, errors.Wrapf(err, "Error retrieving load balancer ingress from the status of cluster %q", clusterStatus.ClusterName)
+		}
+		for _, point := range clusterIngress {
+			if !containsValue(ingress, point) {
+				ingress = append(ingress, point)
+			}
+		}
+	}
+	if len(ingress) == 0 {
+		return nil, nil
+	}
+	return map[string]interface{}{
+		"loadBalancer": map[string]interface{}{
+			"ingress": ingress,
+		},
+	}, nil
+}
+
+// serviceAccountInterpreter interprets service accounts, retaining
+// the secrets generated in member clusters.
+type serviceAccountInterpreter struct {
+	defaultInterpreter
+}
+
+func (serviceAccountInterpreter) Retain(desiredObj, clusterObj *unstructured.Unstructured) error {
+	return retainServiceAccountFields(desiredObj, clusterObj)
+}
+
+func containsValue(values []interface{}, value interface{}) bool {
+	for _, v := range values {
+		if reflect.DeepEqual(v, value) {
+			return true
+		}
+	}
+	return false
+}
+
+func retainServiceFields(desiredObj, clusterObj *unstructured.Unstructured) error {
+	// healthCheckNodePort is allocated by APIServer and unchangeable, so it should be retained while updating
+	healthCheckNodePort, ok, err := unstructured.NestedInt64(clusterObj.Object, util.SpecField, util.HealthCheckNodePortField)
+	if err != nil {
+		return errors.Wrap(err, "Error retrieving healthCheckNodePort from service")
+	}
+	if ok && healthCheckNodePort > 0 {
+		if err = unstructured.SetNestedField(desiredObj.Object, healthCheckNodePort, util.SpecField, util.HealthCheckNodePortField); err != nil {
+			return errors.Wrap(err, "Error setting healthCheckNodePort for service")
+		}
+	}
+
+	// ClusterIP and NodePort are allocated to Service by cluster, so retain the same if any while updating
+
+	// Retain clusterip and clusterips
+	clusterIP, ok, err := unstructured.NestedString(clusterObj.Object, util.SpecField, util.ClusterIPField)
+	if err != nil {
+		return errors.Wrap(err, "Error retrieving clusterIP from cluster service")
+	}
+	// !ok could indicate that a cluster ip was not assigned
+	if ok && clusterIP != "" {
+		err := unstructured.SetNestedField(desiredObj.Object, clusterIP, util.SpecField, util.ClusterIPField)
+		if err != nil {
+			return errors.Wrap(err, "Error setting clusterIP for service")
+		}
+	}
+	clusterIPs, ok, err := unstructured.NestedStringSlice(clusterObj.Object, util.SpecField, util.ClusterIPsField)
+	if err != nil {
+		return errors.Wrap(err, "Error retrieving clusterIPs from cluster service")
+	}
+	// !ok could indicate that cluster ips was not assigned
+	if ok && len(clusterIPs) > 0 {
+		err := unstructured.SetNestedStringSlice(desiredObj.Object, clusterIPs, util.SpecField, util.ClusterIPsField)
+		if err != nil {
+			return errors.Wrap(err, "Error setting clusterIPs for service")
+		}
+	}
+
+	// Retain nodeports
+	clusterPorts, ok, err := unstructured.NestedSlice(clusterObj.Object, util.SpecField, util.PortsField)
+	if err != nil {
+		return errors.Wrap(err, "Error retrieving ports from cluster service")
+	}
+	if !ok {
+		return nil
+	}
+	var desiredPorts []interface{}
+	desiredPorts, ok, err = unstructured.NestedSlice(desiredObj.Object, util.SpecField, util.PortsField)
+	if err != nil {
+		return errors.Wrap(err, "Error retrieving ports from service")
+	}
+	if !ok {
+		desiredPorts = []interface{}{}
+	}
+	for desiredIndex := range desiredPorts {
+		for clusterIndex := range clusterPorts {
+			fPort := desiredPorts[desiredIndex].(map[string]interface{})
+			cPort := clusterPorts[clusterIndex].(map[string]interface{})
+			if !(fPort["name"] == cPort["name"] && fPort["protocol"] == cPort["protocol"] && fPort["port"] == cPort["port"]) {
+				continue
+			}
+			nodePort, ok := cPort["nodePort"]
+			if ok {
+				fPort["nodePort"] = nodePort
+			}
+		}
+	}
+	err = unstructured.SetNestedSlice(desiredObj.Object, desiredPorts, util.SpecField, util.PortsField)
+	if err != nil {
+		return errors.Wrap(err, "Error setting ports for service")
+	}
+
+	return nil
+}
+
+// retainServiceAccountFields retains the 'secrets' field of a service account
+// if the desired representation does not include a value for the field.  This
+// ensures that the sync controller doesn't continually clear a generated
+// secret from a service account, prompting continual regeneration by the
+// service account controller in the member cluster.
+//
+// TODO(marun) Clearing a manually-set secrets field will require resetting
+// placement.  Is there a better way to do this?
+func retainServiceAccountFields(desiredObj, clusterObj *unstructured.Unstructured) error {
+	// Check whether the secrets field is populated in the desired object.
+	desiredSecrets, ok, err := unstructured.NestedSlice(desiredObj.Object, util.SecretsField)
+	if err != nil {
+		return errors.Wrap(err, "Error retrieving secrets from desired service account")
+	}
+	if ok && len(desiredSecrets) > 0 {
+		// Field is populated, so an update to the target resource does not
+		// risk triggering a race with the service account controller.
+		return nil
+	}
+
+	// Retrieve the secrets from the cluster object and retain them.
+	secrets, ok, err := unstructured.NestedSlice(clusterObj.Object, util.SecretsField)
+	if err != nil {
+		return errors.Wrap(err, "Error retrieving secrets from service account")
+	}
+	if ok && len(secrets) > 0 {
+		err := unstructured.SetNestedField(desiredObj.Object, secrets, util.SecretsField)
+		if err != nil {
+			return errors.Wrap(err, "Error setting secrets for service account")
+		}
+	}
+	return nil
+}
@@ -0,0 +1,365 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package interpreter
+
+import (
+	"reflect"
+	"testing"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/utils/pointer"
+
+	"sigs.k8s.io/kubefed/pkg/controller/util"
+)
+
+func TestGetReplicas(t *testing.T) {
+	testCases := map[string]struct {
+		obj                   map[string]interface{}
+		expectedReplicas      int64
+		expectedReadyReplicas int64
+		expectedFound         bool
+	}{
+		"resource without replicas": {
+			obj: map[string]interface{}{
+				"spec": map[string]interface{}{},
+			},
+		},
+		"resource with replicas that are not ready": {
+			obj: map[string]interface{}{
+				"spec": map[string]interface{}{
+					"replicas": int64(3),
+				},
+			},
+			expectedReplicas: 3,
+			expectedFound:    true,
+		},
+		"resource with ready replicas": {
+			obj: map[string]interface{}{
+				"spec": map[string]interface{}{
+					"replicas": int64(3),
+				},
+				"status": map[string]interface{}{
+					"readyReplicas": int64(2),
+				},
+			},
+			expectedReplicas:      3,
+			expectedReadyReplicas: 2,
+			expectedFound:         true,
+		},
+	}
+
+	for testName, tc := range testCases {
+		t.Run(testName, func(t *testing.T) {
+			interpreter := ForGroupKind(schema.GroupKind{Group: "apps", Kind: "Deployment"})
+			replicas, readyReplicas, found, err := interpreter.GetReplicas(&unstructured.Unstructured{Object: tc.obj})
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if replicas != tc.expectedReplicas || readyReplicas != tc.expectedReadyReplicas || found != tc.expectedFound {
+				t.Fatalf("Expected replicas %d, ready replicas %d and found %v, got %d, %d and %v",
+					tc.expectedReplicas, tc.expectedReadyReplicas, tc.expectedFound, replicas, readyReplicas, found)
+			}
+		})
+	}
+}
+
+func TestAggregateStatus(t *testing.T) {
+	testCases := map[string]struct {
+		groupKind       schema.GroupKind
+		clusterStatuses []util.ResourceClusterStatus
+		expected        map[string]interface{}
+	}{
+		"status of a kind without aggregation": {
+			groupKind: schema.GroupKind{Kind: "ConfigMap"},
+			clusterStatuses: []util.ResourceClusterStatus{
+				{ClusterName: "cluster1", Status: map[string]interface{}{"phase": "Ready"}},
+			},
+		},
+		"replicas of a deployment are summed up": {
+			groupKind: schema.GroupKind{Group: "apps", Kind: "Deployment"},
+			clusterStatuses: []util.ResourceClusterStatus{
+				{ClusterName: "cluster1", Status: map[string]interface{}{
+					"replicas":          int64(2),
+					"readyReplicas":     int64(2),
+					"availableReplicas": int64(2),
+					"updatedReplicas":   int64(2),
+				}},
+				{ClusterName: "cluster2", Status: map[string]interface{}{
+					"replicas":      int64(3),
+					"readyReplicas": int64(1),
+				}},
+				{ClusterName: "cluster3"},
+			},
+			expected: map[string]interface{}{
+				"replicas":          int64(5),
+				"readyReplicas":     int64(3),
+				"availableReplicas": int64(2),
+				"updatedReplicas":   int64(2),
+			},
+		},
+		"service without load balancer ingress": {
+			groupKind: schema.GroupKind{Kind: util.ServiceKind},
+			clusterStatuses: []util.ResourceClusterStatus{
+				{ClusterName: "cluster1", Status: map[string]interface{}{}},
+			},
+		},
+		"load balancer ingress of a service is merged": {
+			groupKind: schema.GroupKind{Kind: util.ServiceKind},
+			clusterStatuses: []util.ResourceClusterStatus{
+				{ClusterName: "cluster1", Status: map[string]interface{}{
+					"loadBalancer": map[string]interface{}{
+						"ingress": []interface{}{
+							map[string]interface{}{"ip": "1.2.3.4"},
+						},
+					},
+				}},
+				{ClusterName: "cluster2", Status: map[string]interface{}{
+					"loadBalancer": map[string]interface{}{
+						"ingress": []interface{}{
+							map[string]interface{}{"ip": "1.2.3.4"},
+							map[string]interface{}{"hostname": "lb.example.com"},
+						},
+					},
+				}},
+			},
+			expected: map[string]interface{}{
+				"loadBalancer": map[string]interface{}{
+					"ingress": []interface{}{
+						map[string]interface{}{"ip": "1.2.3.4"},
+						map[string]interface{}{"hostname": "lb.example.com"},
+					},
+				},
+			},
+		},
+	}
+
+	for testName, tc := range testCases {
+		t.Run(testName, func(t *testing.T) {
+			aggregated, err := ForGroupKind(tc.groupKind).AggregateStatus(tc.clusterStatuses)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if !reflect.DeepEqual(aggregated, tc.expected) {
+				t.Fatalf("Expected aggregated status %v, got %v", tc.expected, aggregated)
+			}
+		})
+	}
+}
+
+func TestRetainHealthCheckNodePortInServiceFields(t *testing.T) {
+	tests := []struct {
+		name          string
+		desiredObj    *unstructured.Unstructured
+		clusterObj    *unstructured.Unstructured
+		retainSucceed bool
+		expectedValue *int64
+	}{
+		{
+			"cluster object has no healthCheckNodePort",
+			&unstructured.Unstructured{
+				Object: map[string]interface{}{},
+			},
+			&unstructured.Unstructured{
+				Object: map[string]interface{}{},
+			},
+			true,
+			nil,
+		},
+		{
+			"cluster object has invalid healthCheckNodePort",
+			&unstructured.Unstructured{
+				Object: map[string]interface{}{},
+			},
+			&unstructured.Unstructured{
+				Object: map[string]interface{}{
+					"spec": map[string]interface{}{
+						"healthCheckNodePort": "invalid string",
+					},
+				},
+			},
+			false,
+			nil,
+		},
+		{
+			"cluster object has healthCheckNodePort 0",
+			&unstructured.Unstructured{
+				Object: map[string]interface{}{},
+			},
+			&unstructured.Unstructured{
+				Object: map[string]interface{}{
+					"spec": map[string]interface{}{
+						"healthCheckNodePort": int64(0),
+					},
+				},
+			},
+			true,
+			nil,
+		},
+		{
+			"cluster object has healthCheckNodePort 1000",
+			&unstructured.Unstructured{
+				Object: map[string]interface{}{},
+			},
+			&unstructured.Unstructured{
+				Object: map[string]interface{}{
+					"spec": map[string]interface{}{
+						"healthCheckNodePort": int64(1000),
+					},
+				},
+			},
+			true,
+			pointer.Int64Ptr(1000),
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if err := retainServiceFields(test.desiredObj, test.clusterObj); (err == nil) != test.retainSucceed {
+				t.Fatalf("test %s fails: unexpected returned error %v", test.name, err)
+			}
+
+			currentValue, ok, err := unstructured.NestedInt64(test.desiredObj.Object, "spec", "healthCheckNodePort")
+			if err != nil {
+				t.Fatalf("test %s fails: %v", test.name, err)
+			}
+			if !ok && test.expectedValue != nil {
+				t.Fatalf("test %s fails: expect specified healthCheckNodePort but not found", test.name)
+			}
+			if ok && (test.expectedValue == nil || *test.expectedValue != currentValue) {
+				t.Fatalf("test %s fails: unexpected current healthCheckNodePort %d", test.name, currentValue)
+			}
+		})
+	}
+}
+
+func TestRetainClusterIPsInServiceFields(t *testing.T) {
+	tests := []struct {
+		name                    string
+		desiredObj              *unstructured.Unstructured
+		clusterObj              *unstructured.Unstructured
+		retainSucceed           bool
+		expectedClusterIPValue  *string
+		expectedClusterIPsValue []string
+	}{
+		{
+			"cluster object has no clusterIP or clusterIPs",
+			&unstructured.Unstructured{
+				Object: map[string]interface{}{},
+			},
+			&unstructured.Unstructured{
+				Object: map[string]interface{}{},
+			},
+			true,
+			nil,
+			nil,
+		},
+		{
+			"cluster object has clusterIP",
+			&unstructured.Unstructured{
+				Object: map[string]interface{}{},
+			},
+			&unstructured.Unstructured{
+				Object: map[string]interface{}{
+					"spec": map[string]interface{}{
+						"clusterIP": -1000,
+					},
+				},
+			},
+			false,
+			nil,
+			nil,
+		},
+		{
+			"cluster object has clusterIP only",
+			&unstructured.Unstructured{
+				Object: map[string]interface{}{},
+			},
+			&unstructured.Unstructured{
+				Object: map[string]interface{}{
+					"spec": map[string]interface{}{
+						"clusterIP": "1.2.3.4",
+					},
+				},
+			},
+			true,
+			pointer.String("1.2.3.4"),
+			nil,
+		},
+		{
+			"cluster object has clusterIPs only",
+			&unstructured.Unstructured{
+				Object: map[string]interface{}{},
+			},
+			&unstructured.Unstructured{
+				Object: map[string]interface{}{
+					"spec": map[string]interface{}{
+						"clusterIPs": []interface{}{"1.2.3.4", "5.6.7.8"},
+					},
+				},
+			},
+			true,
+			nil,
+			[]string{"1.2.3.4", "5.6.7.8"},
+		},
+		{
+			"cluster object has both clusterIP and clusterIPs",
+			&unstructured.Unstructured{
+				Object: map[string]interface{}{},
+			},
+			&unstructured.Unstructured{
+				Object: map[string]interface{}{
+					"spec": map[string]interface{}{
+						"clusterIP":  "1.2.3.4",
+						"clusterIPs": []interface{}{"5.6.7.8", "9.10.11.12"},
+					},
+				},
+			},
+			true,
+			pointer.String("1.2.3.4"),
+			[]string{"5.6.7.8", "9.10.11.12"},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if err := retainServiceFields(test.desiredObj, test.clusterObj); (err == nil) != test.retainSucceed {
+				t.Fatalf("test %s fails: unexpected returned error %v", test.name, err)
+			}
+
+			currentClusterIPValue, ok, err := unstructured.NestedString(test.desiredObj.Object, "spec", "clusterIP")
+			if err != nil {
+				t.Fatalf("test %s fails: %v", test.name, err)
+			}
+			if !ok && test.expectedClusterIPValue != nil {
+				t.Fatalf("test %s fails: expect specified clusterIP but not found", test.name)
+			}
+			if ok && (test.expectedClusterIPValue == nil || *test.expectedClusterIPValue != currentClusterIPValue) {
+				t.Fatalf("test %s fails: unexpected current clusterIP %s", test.name, currentClusterIPValue)
+			}
+
+			currentClusterIPsValue, ok, err := unstructured.NestedStringSlice(test.desiredObj.Object, "spec", "clusterIPs")
+			if err != nil {
+				t.Fatalf("test %s fails: %v", test.name, err)
+			}
+			if !ok && test.expectedClusterIPsValue != nil {
+				t.Fatalf("test %s fails: expect specified clusterIPs but not found", test.name)
+			}
+			if ok && !reflect.DeepEqual(test.expectedClusterIPsValue, currentClusterIPsValue) {
+				t.Fatalf("test %s fails: unexpected current clusterIPs %v", test.name, currentClusterIPsValue)
+			}
+		})
+	}
+}
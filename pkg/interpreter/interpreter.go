@@ -0,0 +1,157 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package interpreter
+
+import (
+	"github.com/pkg/errors"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"sigs.k8s.io/kubefed/pkg/apis/core/typeconfig"
+	"sigs.k8s.io/kubefed/pkg/controller/util"
+)
+
+// Interpreter implements the behavior of KubeFed that depends on the
+// kind of the resources propagated to member clusters.
+type Interpreter interface {
+	// Retain updates the desired object with the values of fields
+	// that are set in the member cluster (e.g. the clusterIP of a
+	// service) and must be retained from the cluster object when
+	// updating it.
+	Retain(desiredObj, clusterObj *unstructured.Unstructured) error
+	// Health determines the health of a resource in a member
+	// cluster.
+	Health(clusterObj *unstructured.Unstructured) (util.ResourceHealth, error)
+	// GetReplicas returns the desired and ready replicas of a
+	// resource. found is false if the resource does not specify
+	// replicas.
+	GetReplicas(obj *unstructured.Unstructured) (replicas, readyReplicas int64, found bool, err error)
+	// SetReplicas sets the desired replicas of a resource.
+	SetReplicas(obj *unstructured.Unstructured, replicas int64) error
+	// ReflectStatus returns the status of a resource in a member
+	// cluster that is collected in the status of the federated
+	// resource.
+	ReflectStatus(clusterObj *unstructured.Unstructured) (map[string]interface{}, error)
+	// AggregateStatus aggregates the status collected from member
+	// clusters. A nil status is returned if the status is not
+	// aggregated.
+	AggregateStatus(clusterStatuses []util.ResourceClusterStatus) (map[string]interface{}, error)
+}
+
+// Replicas are the desired and ready replicas of a resource.
+type Replicas struct {
+	Replicas      int64
+	ReadyReplicas int64
+}
+
+// clusterInterpreter is implemented by interpreters that interpret
+// the resources of a federated resource in all member clusters at
+// once rather than one resource at a time.
+type clusterInterpreter interface {
+	healthForClusters(clusterObjs map[string]*unstructured.Unstructured) (map[string]util.ResourceHealth, error)
+	replicasForClusters(clusterObjs map[string]*unstructured.Unstructured) (map[string]Replicas, error)
+	reflectStatusForClusters(clusterObjs map[string]*unstructured.Unstructured) (map[string]map[string]interface{}, error)
+}
+
+// HealthForClusters determines the health of the resources of a
+// federated resource in the given member clusters, keyed by cluster
+// name.
+func HealthForClusters(interp Interpreter, clusterObjs map[string]*unstructured.Unstructured) (map[string]util.ResourceHealth, error) {
+	if ci, ok := interp.(clusterInterpreter); ok {
+		return ci.healthForClusters(clusterObjs)
+	}
+	health := make(map[string]util.ResourceHealth, len(clusterObjs))
+	for clusterName, clusterObj := range clusterObjs {
+		clusterHealth, err := interp.Health(clusterObj)
+		if err != nil {
+			return nil, errors.Wrapf(err, "Failed to determine the health of the resource in cluster %q", clusterName)
+		}
+		health[clusterName] = clusterHealth
+	}
+	return health, nil
+}
+
+// ReplicasForClusters returns the desired and ready replicas of the
+// resources of a federated resource in the given member clusters,
+// keyed by cluster name. Resources that do not specify replicas are
+// omitted.
+func ReplicasForClusters(interp Interpreter, clusterObjs map[string]*unstructured.Unstructured) (map[string]Replicas, error) {
+	if ci, ok := interp.(clusterInterpreter); ok {
+		return ci.replicasForClusters(clusterObjs)
+	}
+	replicas := make(map[string]Replicas, len(clusterObjs))
+	for clusterName, clusterObj := range clusterObjs {
+		desired, ready, found, err := interp.GetReplicas(clusterObj)
+		if err != nil {
+			return nil, errors.Wrapf(err, "Failed to get the replicas of the resource in cluster %q", clusterName)
+		}
+		if found {
+			replicas[clusterName] = Replicas{Replicas: desired, ReadyReplicas: ready}
+		}
+	}
+	return replicas, nil
+}
+
+// ReflectStatusForClusters returns the status of the resources of a
+// federated resource in the given member clusters that is collected
+// in the status of the federated resource, keyed by cluster name.
+func ReflectStatusForClusters(interp Interpreter, clusterObjs map[string]*unstructured.Unstructured) (map[string]map[string]interface{}, error) {
+	if ci, ok := interp.(clusterInterpreter); ok {
+		return ci.reflectStatusForClusters(clusterObjs)
+	}
+	statuses := make(map[string]map[string]interface{}, len(clusterObjs))
+	for clusterName, clusterObj := range clusterObjs {
+		status, err := interp.ReflectStatus(clusterObj)
+		if err != nil {
+			return nil, errors.Wrapf(err, "Failed to reflect the status of the resource in cluster %q", clusterName)
+		}
+		statuses[clusterName] = status
+	}
+	return statuses, nil
+}
+
+var builtinInterpreters = map[schema.GroupKind]Interpreter{
+	{Group: "apps", Kind: "Deployment"}:        workloadInterpreter{},
+	{Group: "apps", Kind: "StatefulSet"}:       workloadInterpreter{},
+	{Group: "apps", Kind: "ReplicaSet"}:        workloadInterpreter{},
+	{Group: "", Kind: util.ServiceKind}:        serviceInterpreter{},
+	{Group: "", Kind: util.ServiceAccountKind}: serviceAccountInterpreter{},
+}
+
+// ForGroupKind returns the built-in interpreter for resources of the
+// given group and kind.
+func ForGroupKind(groupKind schema.GroupKind) Interpreter {
+	if interpreter, ok := builtinInterpreters[groupKind]; ok {
+		return interpreter
+	}
+	return defaultInterpreter{}
+}
+
+// ForTypeConfig returns the interpreter for the target type of the
+// given type config. Operations that are delegated to the webhook of
+// the type config fall back to the built-in interpreter for the
+// target type otherwise.
+func ForTypeConfig(typeConfig typeconfig.Interface) (Interpreter, error) {
+	targetType := typeConfig.GetTargetType()
+	builtin := ForGroupKind(schema.GroupKind{Group: targetType.Group, Kind: targetType.Kind})
+	config := typeConfig.GetInterpreter()
+	if config == nil || config.Webhook == nil {
+		return builtin, nil
+	}
+	return NewWebhookInterpreter(config.Webhook, builtin)
+}
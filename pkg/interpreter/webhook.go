@@ -0,0 +1,385 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package interpreter
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	utiljson "k8s.io/apimachinery/pkg/util/json"
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	fedv1b1 "sigs.k8s.io/kubefed/pkg/apis/core/v1beta1"
+	"sigs.k8s.io/kubefed/pkg/controller/util"
+)
+
+const (
+	defaultWebhookTimeout = 10 * time.Second
+
+	// maxResponseBytes limits the size of the responses read from
+	// interpreter webhooks.
+	maxResponseBytes = 3 * 1024 * 1024
+)
+
+// Request is posted as JSON to an interpreter webhook to interpret a
+// resource for an operation. The Health, GetReplicas and
+// ReflectStatus operations are requested either for a single
+// resource or for the resources of a federated resource in all member
+// clusters at once.
+type Request struct {
+	// The operation to interpret.
+	Operation fedv1b1.InterpreterOperation `json:"operation"`
+	// The desired resource for the Retain and SetReplicas operations,
+	// or the resource in a member cluster for the Health, GetReplicas
+	// and ReflectStatus operations.
+	Object map[string]interface{} `json:"object,omitempty"`
+	// The resource in a member cluster for the Retain operation.
+	ClusterObject map[string]interface{} `json:"clusterObject,omitempty"`
+	// The resources in member clusters, keyed by cluster name, for
+	// the Health, GetReplicas and ReflectStatus operations requested
+	// for all member clusters at once.
+	ClusterObjects map[string]map[string]interface{} `json:"clusterObjects,omitempty"`
+	// The replicas to set for the SetReplicas operation.
+	Replicas *int64 `json:"replicas,omitempty"`
+	// The status collected from member clusters for the
+	// AggregateStatus operation.
+	ClusterStatuses []util.ResourceClusterStatus `json:"clusterStatuses,omitempty"`
+}
+
+// Response is returned as JSON by an interpreter webhook.
+type Response struct {
+	// The updated desired resource for the Retain and SetReplicas
+	// operations.
+	Object map[string]interface{} `json:"object,omitempty"`
+	// The health of the resource for the Health operation.
+	Health util.ResourceHealth `json:"health,omitempty"`
+	// The desired and ready replicas of the resource for the
+	// GetReplicas operation. The resource does not specify replicas
+	// if replicas is not set.
+	Replicas      *int64 `json:"replicas,omitempty"`
+	ReadyReplicas *int64 `json:"readyReplicas,omitempty"`
+	// The reflected status for the ReflectStatus operation, or the
+	// aggregated status for the AggregateStatus operation.
+	Status map[string]interface{} `json:"status,omitempty"`
+	// The responses for the resources of a request with
+	// clusterObjects, keyed by cluster name.
+	Clusters map[string]Response `json:"clusters,omitempty"`
+}
+
+// webhookInterpreter delegates the configured operations to a
+// webhook and the other operations to a fallback interpreter.
+type webhookInterpreter struct {
+	url        string
+	client     *http.Client
+	timeout    time.Duration
+	operations sets.String
+	fallback   Interpreter
+}
+
+// NewWebhookInterpreter returns an interpreter that delegates the
+// operations configured for the given webhook to the webhook, and the
+// other operations to the given fallback interpreter.
+func NewWebhookInterpreter(webhook *fedv1b1.InterpreterWebhook, fallback Interpreter) (Interpreter, error) {
+	tlsConfig := &tls.Config{MinVersion: tls.VersionTLS12}
+	if len(webhook.CABundle) > 0 {
+		tlsConfig.RootCAs = x509.NewCertPool()
+		if !tlsConfig.RootCAs.AppendCertsFromPEM(webhook.CABundle) {
+			return nil, errors.Errorf("Failed to parse the CA bundle of interpreter webhook %q", webhook.URL)
+		}
+	}
+	timeout := defaultWebhookTimeout
+	if webhook.TimeoutSeconds != nil {
+		timeout = time.Duration(*webhook.TimeoutSeconds) * time.Second
+	}
+	operations := sets.NewString()
+	for _, operation := range webhook.Operations {
+		operations.Insert(string(operation))
+	}
+	return &webhookInterpreter{
+		url: webhook.URL,
+		client: &http.Client{
+			Transport: &http.Transport{
+				Proxy:           http.ProxyFromEnvironment,
+				TLSClientConfig: tlsConfig,
+			},
+		},
+		timeout:    timeout,
+		operations: operations,
+		fallback:   fallback,
+	}, nil
+}
+
+func (w *webhookInterpreter) Retain(desiredObj, clusterObj *unstructured.Unstructured) error {
+	if !w.operations.Has(string(fedv1b1.InterpretRetain)) {
+		return w.fallback.Retain(desiredObj, clusterObj)
+	}
+	response, err := w.interpret(&Request{
+		Operation:     fedv1b1.InterpretRetain,
+		Object:        desiredObj.Object,
+		ClusterObject: clusterObj.Object,
+	})
+	if err != nil {
+		return err
+	}
+	return w.updateObject(desiredObj, response)
+}
+
+func (w *webhookInterpreter) Health(clusterObj *unstructured.Unstructured) (util.ResourceHealth, error) {
+	if !w.operations.Has(string(fedv1b1.InterpretHealth)) {
+		return w.fallback.Health(clusterObj)
+	}
+	response, err := w.interpret(&Request{
+		Operation: fedv1b1.InterpretHealth,
+		Object:    clusterObj.Object,
+	})
+	if err != nil {
+		return "", err
+	}
+	return w.health(response)
+}
+
+func (w *webhookInterpreter) healthForClusters(clusterObjs map[string]*unstructured.Unstructured) (map[string]util.ResourceHealth, error) {
+	if !w.operations.Has(string(fedv1b1.InterpretHealth)) {
+		return HealthForClusters(w.fallback, clusterObjs)
+	}
+	responses, err := w.interpretForClusters(fedv1b1.InterpretHealth, clusterObjs)
+	if err != nil {
+		return nil, err
+	}
+	health := make(map[string]util.ResourceHealth, len(responses))
+	for clusterName, response := range responses {
+		clusterHealth, err := w.health(response)
+		if err != nil {
+			return nil, errors.Wrapf(err, "Invalid response for cluster %q", clusterName)
+		}
+		health[clusterName] = clusterHealth
+	}
+	return health, nil
+}
+
+// health returns the health from the response to a Health request.
+func (w *webhookInterpreter) health(response *Response) (util.ResourceHealth, error) {
+	switch response.Health {
+	case util.ResourceHealthy, util.ResourceProgressing, util.ResourceDegraded:
+		return response.Health, nil
+	}
+	return "", errors.Errorf("Interpreter webhook %q returned invalid health %q", w.url, response.Health)
+}
+
+func (w *webhookInterpreter) GetReplicas(obj *unstructured.Unstructured) (int64, int64, bool, error) {
+	if !w.operations.Has(string(fedv1b1.InterpretGetReplicas)) {
+		return w.fallback.GetReplicas(obj)
+	}
+	response, err := w.interpret(&Request{
+		Operation: fedv1b1.InterpretGetReplicas,
+		Object:    obj.Object,
+	})
+	if err != nil {
+		return 0, 0, false, err
+	}
+	replicas, found := replicasFromResponse(response)
+	return replicas.Replicas, replicas.ReadyReplicas, found, nil
+}
+
+func (w *webhookInterpreter) replicasForClusters(clusterObjs map[string]*unstructured.Unstructured) (map[string]Replicas, error) {
+	if !w.operations.Has(string(fedv1b1.InterpretGetReplicas)) {
+		return ReplicasForClusters(w.fallback, clusterObjs)
+	}
+	responses, err := w.interpretForClusters(fedv1b1.InterpretGetReplicas, clusterObjs)
+	if err != nil {
+		return nil, err
+	}
+	replicas := make(map[string]Replicas, len(responses))
+	for clusterName, response := range responses {
+		if clusterReplicas, found := replicasFromResponse(response); found {
+			replicas[clusterName] = clusterReplicas
+		}
+	}
+	return replicas, nil
+}
+
+// replicasFromResponse returns the replicas from the response to a
+// GetReplicas request. found is false if the resource does not
+// specify replicas.
+func replicasFromResponse(response *Response) (replicas Replicas, found bool) {
+	if response.Replicas == nil {
+		return Replicas{}, false
+	}
+	replicas.Replicas = *response.Replicas
+	if response.ReadyReplicas != nil {
+		replicas.ReadyReplicas = *response.ReadyReplicas
+	}
+	return replicas, true
+}
+
+func (w *webhookInterpreter) SetReplicas(obj *unstructured.Unstructured, replicas int64) error {
+	if !w.operations.Has(string(fedv1b1.InterpretSetReplicas)) {
+		return w.fallback.SetReplicas(obj, replicas)
+	}
+	response, err := w.interpret(&Request{
+		Operation: fedv1b1.InterpretSetReplicas,
+		Object:    obj.Object,
+		Replicas:  &replicas,
+	})
+	if err != nil {
+		return err
+	}
+	return w.updateObject(obj, response)
+}
+
+func (w *webhookInterpreter) ReflectStatus(clusterObj *unstructured.Unstructured) (map[string]interface{}, error) {
+	if !w.operations.Has(string(fedv1b1.InterpretReflectStatus)) {
+		return w.fallback.ReflectStatus(clusterObj)
+	}
+	response, err := w.interpret(&Request{
+		Operation: fedv1b1.InterpretReflectStatus,
+		Object:    clusterObj.Object,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return response.Status, nil
+}
+
+func (w *webhookInterpreter) reflectStatusForClusters(clusterObjs map[string]*unstructured.Unstructured) (map[string]map[string]interface{}, error) {
+	if !w.operations.Has(string(fedv1b1.InterpretReflectStatus)) {
+		return ReflectStatusForClusters(w.fallback, clusterObjs)
+	}
+	responses, err := w.interpretForClusters(fedv1b1.InterpretReflectStatus, clusterObjs)
+	if err != nil {
+		return nil, err
+	}
+	statuses := make(map[string]map[string]interface{}, len(responses))
+	for clusterName, response := range responses {
+		statuses[clusterName] = response.Status
+	}
+	return statuses, nil
+}
+
+func (w *webhookInterpreter) AggregateStatus(clusterStatuses []util.ResourceClusterStatus) (map[string]interface{}, error) {
+	if !w.operations.Has(string(fedv1b1.InterpretAggregateStatus)) {
+		return w.fallback.AggregateStatus(clusterStatuses)
+	}
+	response, err := w.interpret(&Request{
+		Operation:       fedv1b1.InterpretAggregateStatus,
+		ClusterStatuses: clusterStatuses,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return response.Status, nil
+}
+
+// updateObject replaces the content of the given object with the
+// object returned by the webhook. The webhook may not change the
+// identity of the object.
+func (w *webhookInterpreter) updateObject(obj *unstructured.Unstructured, response *Response) error {
+	if response.Object == nil {
+		return errors.Errorf("Interpreter webhook %q did not return an object", w.url)
+	}
+	updatedObj := &unstructured.Unstructured{Object: response.Object}
+	if updatedObj.GroupVersionKind() != obj.GroupVersionKind() ||
+		updatedObj.GetNamespace() != obj.GetNamespace() ||
+		updatedObj.GetName() != obj.GetName() {
+		return errors.Errorf("Interpreter webhook %q changed the api version, kind, namespace or name of the object", w.url)
+	}
+	obj.Object = updatedObj.Object
+	return nil
+}
+
+// interpretForClusters interprets the given resources in member
+// clusters with a single request to the webhook, and returns the
+// responses for the resources keyed by cluster name.
+func (w *webhookInterpreter) interpretForClusters(operation fedv1b1.InterpreterOperation, clusterObjs map[string]*unstructured.Unstructured) (map[string]*Response, error) {
+	responses := make(map[string]*Response, len(clusterObjs))
+	if len(clusterObjs) == 0 {
+		return responses, nil
+	}
+	request := &Request{
+		Operation:      operation,
+		ClusterObjects: make(map[string]map[string]interface{}, len(clusterObjs)),
+	}
+	for clusterName, clusterObj := range clusterObjs {
+		request.ClusterObjects[clusterName] = clusterObj.Object
+	}
+	response, err := w.interpret(request)
+	if err != nil {
+		return nil, err
+	}
+	for clusterName := range clusterObjs {
+		clusterResponse, ok := response.Clusters[clusterName]
+		if !ok {
+			return nil, errors.Errorf("Interpreter webhook %q did not return a response for cluster %q", w.url, clusterName)
+		}
+		responses[clusterName] = &clusterResponse
+	}
+	return responses, nil
+}
+
+// interpret posts the given request to the webhook and decodes its
+// response. The request is aborted if the webhook does not respond
+// within the configured timeout.
+func (w *webhookInterpreter) interpret(request *Request) (*Response, error) {
+	body, err := json.Marshal(request)
+	if err != nil {
+		return nil, errors.Wrapf(err, "Failed to encode %s request for interpreter webhook %q", request.Operation, w.url)
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), w.timeout)
+	defer cancel()
+	httpRequest, err := http.NewRequestWithContext(ctx, http.MethodPost, w.url, bytes.NewReader(body))
+	if err != nil {
+		return nil, errors.Wrapf(err, "Failed to create %s request for interpreter webhook %q", request.Operation, w.url)
+	}
+	httpRequest.Header.Set("Content-Type", "application/json")
+	httpResponse, err := w.client.Do(httpRequest)
+	if err != nil {
+		return nil, errors.Wrapf(err, "Failed to call interpreter webhook %q for %s", w.url, request.Operation)
+	}
+	defer httpResponse.Body.Close()
+	responseBody, err := io.ReadAll(io.LimitReader(httpResponse.Body, maxResponseBytes))
+	if err != nil {
+		return nil, errors.Wrapf(err, "Failed to read the response of interpreter webhook %q for %s", w.url, request.Operation)
+	}
+	if httpResponse.StatusCode != http.StatusOK {
+		return nil, errors.Errorf("Interpreter webhook %q returned %s for %s: %s", w.url, httpResponse.Status, request.Operation, bytes.TrimSpace(responseBody))
+	}
+	response := &Response{}
+	if err := decodeJSON(responseBody, response); err != nil {
+		return nil, errors.Wrapf(err, "Failed to decode the response of interpreter webhook %q for %s", w.url, request.Operation)
+	}
+	return response, nil
+}
+
+// decodeJSON decodes the given JSON object into the given struct,
+// decoding numbers in nested objects as int64 where possible.
+func decodeJSON(data []byte, into interface{}) error {
+	raw := make(map[string]interface{})
+	if err := utiljson.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+	return runtime.DefaultUnstructuredConverter.FromUnstructured(raw, into)
+}
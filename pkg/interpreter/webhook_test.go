@@ -0,0 +1,349 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package interpreter
+
+import (
+	"encoding/json"
+	"encoding/pem"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+	"time"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	fedv1b1 "sigs.k8s.io/kubefed/pkg/apis/core/v1beta1"
+	"sigs.k8s.io/kubefed/pkg/controller/util"
+)
+
+func newTestWebhookInterpreter(t *testing.T, handler func(request *Request) *Response, operations ...fedv1b1.InterpreterOperation) Interpreter {
+	return newTestWebhookInterpreterWithConfig(t, handler, &fedv1b1.InterpreterWebhook{Operations: operations})
+}
+
+func newTestWebhookInterpreterWithConfig(t *testing.T, handler func(request *Request) *Response, webhook *fedv1b1.InterpreterWebhook) Interpreter {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(r.Body)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		request := &Request{}
+		if err := decodeJSON(body, request); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		response := handler(request)
+		if response == nil {
+			http.Error(w, "unsupported operation", http.StatusBadRequest)
+			return
+		}
+		_ = json.NewEncoder(w).Encode(response)
+	}))
+	t.Cleanup(server.Close)
+
+	caBundle := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw})
+	webhook.URL = server.URL
+	webhook.CABundle = caBundle
+	interpreter, err := NewWebhookInterpreter(webhook, ForGroupKind(schema.GroupKind{Group: "apps", Kind: "Deployment"}))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	return interpreter
+}
+
+func newTestObject(spec map[string]interface{}) *unstructured.Unstructured {
+	return &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "example.io/v1",
+		"kind":       "Widget",
+		"metadata": map[string]interface{}{
+			"name":      "foo",
+			"namespace": "bar",
+		},
+		"spec": spec,
+	}}
+}
+
+func TestWebhookInterpreter(t *testing.T) {
+	interpreter := newTestWebhookInterpreter(t, func(request *Request) *Response {
+		switch request.Operation {
+		case fedv1b1.InterpretRetain:
+			obj := &unstructured.Unstructured{Object: request.Object}
+			size, _, _ := unstructured.NestedInt64(request.ClusterObject, "spec", "size")
+			_ = unstructured.SetNestedField(obj.Object, size, "spec", "size")
+			return &Response{Object: obj.Object}
+		case fedv1b1.InterpretHealth:
+			return &Response{Health: util.ResourceDegraded}
+		case fedv1b1.InterpretGetReplicas:
+			replicas, _, _ := unstructured.NestedInt64(request.Object, "spec", "instances")
+			return &Response{Replicas: &replicas}
+		case fedv1b1.InterpretSetReplicas:
+			_ = unstructured.SetNestedField(request.Object, *request.Replicas, "spec", "instances")
+			return &Response{Object: request.Object}
+		case fedv1b1.InterpretAggregateStatus:
+			return &Response{Status: map[string]interface{}{"clusters": int64(len(request.ClusterStatuses))}}
+		}
+		return nil
+	}, fedv1b1.InterpretRetain, fedv1b1.InterpretHealth, fedv1b1.InterpretGetReplicas, fedv1b1.InterpretSetReplicas, fedv1b1.InterpretAggregateStatus)
+
+	t.Run("Retain", func(t *testing.T) {
+		desiredObj := newTestObject(map[string]interface{}{"size": int64(1)})
+		clusterObj := newTestObject(map[string]interface{}{"size": int64(2)})
+		if err := interpreter.Retain(desiredObj, clusterObj); err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		size, _, _ := unstructured.NestedFieldNoCopy(desiredObj.Object, "spec", "size")
+		if size != int64(2) {
+			t.Fatalf("Expected retained size 2, got %v", size)
+		}
+	})
+
+	t.Run("Health", func(t *testing.T) {
+		health, err := interpreter.Health(newTestObject(nil))
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if health != util.ResourceDegraded {
+			t.Fatalf("Expected health %q, got %q", util.ResourceDegraded, health)
+		}
+	})
+
+	t.Run("Replicas", func(t *testing.T) {
+		obj := newTestObject(map[string]interface{}{"instances": int64(1)})
+		if err := interpreter.SetReplicas(obj, 4); err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		replicas, _, found, err := interpreter.GetReplicas(obj)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if !found || replicas != 4 {
+			t.Fatalf("Expected 4 replicas, got %d (found: %v)", replicas, found)
+		}
+	})
+
+	t.Run("ReflectStatus falls back to the built-in interpreter", func(t *testing.T) {
+		obj := newTestObject(nil)
+		obj.Object["status"] = map[string]interface{}{"phase": "Ready"}
+		status, err := interpreter.ReflectStatus(obj)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		expected := map[string]interface{}{"phase": "Ready"}
+		if !reflect.DeepEqual(status, expected) {
+			t.Fatalf("Expected status %v, got %v", expected, status)
+		}
+	})
+
+	t.Run("AggregateStatus", func(t *testing.T) {
+		status, err := interpreter.AggregateStatus([]util.ResourceClusterStatus{{ClusterName: "cluster1"}, {ClusterName: "cluster2"}})
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		expected := map[string]interface{}{"clusters": int64(2)}
+		if !reflect.DeepEqual(status, expected) {
+			t.Fatalf("Expected status %v, got %v", expected, status)
+		}
+	})
+}
+
+func TestWebhookInterpreterErrors(t *testing.T) {
+	testCases := map[string]struct {
+		response  *Response
+		operation func(Interpreter) error
+	}{
+		"webhook fails": {
+			operation: func(interpreter Interpreter) error {
+				_, err := interpreter.Health(newTestObject(nil))
+				return err
+			},
+		},
+		"invalid health": {
+			response: &Response{Health: "Unknown"},
+			operation: func(interpreter Interpreter) error {
+				_, err := interpreter.Health(newTestObject(nil))
+				return err
+			},
+		},
+		"no object returned": {
+			response: &Response{},
+			operation: func(interpreter Interpreter) error {
+				return interpreter.Retain(newTestObject(nil), newTestObject(nil))
+			},
+		},
+		"name of object changed": {
+			response: &Response{Object: map[string]interface{}{
+				"apiVersion": "example.io/v1",
+				"kind":       "Widget",
+				"metadata": map[string]interface{}{
+					"name":      "other",
+					"namespace": "bar",
+				},
+			}},
+			operation: func(interpreter Interpreter) error {
+				return interpreter.Retain(newTestObject(nil), newTestObject(nil))
+			},
+		},
+	}
+
+	for testName, tc := range testCases {
+		t.Run(testName, func(t *testing.T) {
+			interpreter := newTestWebhookInterpreter(t, func(*Request) *Response {
+				return tc.response
+			}, fedv1b1.InterpretRetain, fedv1b1.InterpretHealth)
+			if err := tc.operation(interpreter); err == nil {
+				t.Fatalf("Expected an error")
+			}
+		})
+	}
+}
+
+func TestWebhookInterpreterForClusters(t *testing.T) {
+	var requests int
+	interpreter := newTestWebhookInterpreter(t, func(request *Request) *Response {
+		requests++
+		response := &Response{Clusters: make(map[string]Response)}
+		for clusterName, obj := range request.ClusterObjects {
+			switch request.Operation {
+			case fedv1b1.InterpretHealth:
+				health := util.ResourceHealthy
+				if clusterName == "cluster2" {
+					health = util.ResourceDegraded
+				}
+				response.Clusters[clusterName] = Response{Health: health}
+			case fedv1b1.InterpretGetReplicas:
+				replicas, found, _ := unstructured.NestedInt64(obj, "spec", "instances")
+				if !found {
+					response.Clusters[clusterName] = Response{}
+					continue
+				}
+				response.Clusters[clusterName] = Response{Replicas: &replicas, ReadyReplicas: &replicas}
+			default:
+				return nil
+			}
+		}
+		return response
+	}, fedv1b1.InterpretHealth, fedv1b1.InterpretGetReplicas)
+
+	clusterObjs := map[string]*unstructured.Unstructured{
+		"cluster1": newTestObject(map[string]interface{}{"instances": int64(2)}),
+		"cluster2": newTestObject(nil),
+	}
+	clusterObjs["cluster2"].Object["status"] = map[string]interface{}{"phase": "Failed"}
+
+	t.Run("Health", func(t *testing.T) {
+		requests = 0
+		health, err := HealthForClusters(interpreter, clusterObjs)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		expected := map[string]util.ResourceHealth{
+			"cluster1": util.ResourceHealthy,
+			"cluster2": util.ResourceDegraded,
+		}
+		if !reflect.DeepEqual(health, expected) {
+			t.Fatalf("Expected health %v, got %v", expected, health)
+		}
+		if requests != 1 {
+			t.Fatalf("Expected a single request, got %d", requests)
+		}
+	})
+
+	t.Run("Replicas", func(t *testing.T) {
+		requests = 0
+		replicas, err := ReplicasForClusters(interpreter, clusterObjs)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		expected := map[string]Replicas{
+			"cluster1": {Replicas: 2, ReadyReplicas: 2},
+		}
+		if !reflect.DeepEqual(replicas, expected) {
+			t.Fatalf("Expected replicas %v, got %v", expected, replicas)
+		}
+		if requests != 1 {
+			t.Fatalf("Expected a single request, got %d", requests)
+		}
+	})
+
+	t.Run("ReflectStatus falls back to the built-in interpreter", func(t *testing.T) {
+		requests = 0
+		statuses, err := ReflectStatusForClusters(interpreter, clusterObjs)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		expected := map[string]map[string]interface{}{
+			"cluster1": nil,
+			"cluster2": {"phase": "Failed"},
+		}
+		if !reflect.DeepEqual(statuses, expected) {
+			t.Fatalf("Expected statuses %v, got %v", expected, statuses)
+		}
+		if requests != 0 {
+			t.Fatalf("Expected no request, got %d", requests)
+		}
+	})
+
+	t.Run("No resources", func(t *testing.T) {
+		requests = 0
+		health, err := HealthForClusters(interpreter, nil)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if len(health) != 0 || requests != 0 {
+			t.Fatalf("Expected no health and no request, got %v and %d requests", health, requests)
+		}
+	})
+}
+
+func TestWebhookInterpreterForClustersErrors(t *testing.T) {
+	clusterObjs := map[string]*unstructured.Unstructured{
+		"cluster1": newTestObject(nil),
+		"cluster2": newTestObject(nil),
+	}
+
+	t.Run("Missing cluster response", func(t *testing.T) {
+		interpreter := newTestWebhookInterpreter(t, func(*Request) *Response {
+			return &Response{Clusters: map[string]Response{
+				"cluster1": {Health: util.ResourceHealthy},
+			}}
+		}, fedv1b1.InterpretHealth)
+		if _, err := HealthForClusters(interpreter, clusterObjs); err == nil {
+			t.Fatalf("Expected an error")
+		}
+	})
+
+	t.Run("Timeout", func(t *testing.T) {
+		timeoutSeconds := int32(1)
+		interpreter := newTestWebhookInterpreterWithConfig(t, func(*Request) *Response {
+			time.Sleep(2 * time.Second)
+			return &Response{}
+		}, &fedv1b1.InterpreterWebhook{
+			Operations:     []fedv1b1.InterpreterOperation{fedv1b1.InterpretHealth},
+			TimeoutSeconds: &timeoutSeconds,
+		})
+		start := time.Now()
+		if _, err := HealthForClusters(interpreter, clusterObjs); err == nil {
+			t.Fatalf("Expected an error")
+		}
+		if elapsed := time.Since(start); elapsed >= 2*time.Second {
+			t.Fatalf("Expected the request to time out after 1s, took %v", elapsed)
+		}
+	})
+}
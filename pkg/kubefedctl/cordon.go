@@ -0,0 +1,455 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kubefedctl
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+
+	apiextv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/rest"
+	"k8s.io/klog/v2"
+
+	fedv1b1 "sigs.k8s.io/kubefed/pkg/apis/core/v1beta1"
+	policyv1a1 "sigs.k8s.io/kubefed/pkg/apis/policy/v1alpha1"
+	fedschedulingv1a1 "sigs.k8s.io/kubefed/pkg/apis/scheduling/v1alpha1"
+	genericclient "sigs.k8s.io/kubefed/pkg/client/generic"
+	controllerutil "sigs.k8s.io/kubefed/pkg/controller/util"
+	"sigs.k8s.io/kubefed/pkg/kubefedctl/options"
+	"sigs.k8s.io/kubefed/pkg/kubefedctl/util"
+)
+
+const drainPollInterval = 2 * time.Second
+
+var (
+	cordonLong = `
+		Cordon marks a member cluster as unschedulable. Federated
+		resources will not be propagated to the cluster unless
+		they have already been propagated to it. Current context
+		is assumed to be a Kubernetes cluster hosting a KubeFed
+		control plane. Please use the --host-cluster-context flag
+		otherwise.`
+	cordonExample = `
+		# Mark the member cluster foo as unschedulable
+		kubefedctl cordon foo --host-cluster-context=bar`
+
+	uncordonLong = `
+		Uncordon marks a member cluster that was cordoned or
+		drained as schedulable again. Current context is assumed
+		to be a Kubernetes cluster hosting a KubeFed control
+		plane. Please use the --host-cluster-context flag
+		otherwise.`
+	uncordonExample = `
+		# Mark the member cluster foo as schedulable
+		kubefedctl uncordon foo --host-cluster-context=bar`
+
+	drainLong = `
+		Drain marks a member cluster as unschedulable and moves
+		federated resources placed by cluster selector, as well as
+		replicas scheduled by a ReplicaSchedulingPreference, to
+		other clusters. Resources that name the cluster in
+		spec.placement.clusters are not moved. The command waits
+		until the moved resources have been removed from the
+		cluster. Current context is assumed to be a Kubernetes
+		cluster hosting a KubeFed control plane. Please use the
+		--host-cluster-context flag otherwise.`
+	drainExample = `
+		# Drain the member cluster foo, waiting up to 30 minutes
+		# for resources to be removed from the cluster
+		kubefedctl drain foo --host-cluster-context=bar --timeout=30m`
+)
+
+type cordonCluster struct {
+	options.GlobalSubcommandOptions
+	clusterName string
+}
+
+type drainCluster struct {
+	cordonCluster
+	timeout time.Duration
+}
+
+// Bind adds the drain specific arguments to the flagset passed in as
+// an argument.
+func (o *drainCluster) Bind(flags *pflag.FlagSet) {
+	flags.DurationVar(&o.timeout, "timeout", 10*time.Minute,
+		"The length of time to wait for resources to be removed from the cluster. A value of zero indicates that the command should not wait.")
+}
+
+// NewCmdCordon defines the `cordon` command that marks a cluster as
+// unschedulable.
+func NewCmdCordon(cmdOut io.Writer, config util.FedConfig) *cobra.Command {
+	opts := &cordonCluster{}
+
+	cmd := &cobra.Command{
+		Use:     "cordon CLUSTER_NAME --host-cluster-context=HOST_CONTEXT",
+		Short:   "Mark a member cluster as unschedulable",
+		Long:    cordonLong,
+		Example: cordonExample,
+		Run: func(cmd *cobra.Command, args []string) {
+			err := opts.Complete(args)
+			if err != nil {
+				klog.Fatalf("Error: %v", err)
+			}
+
+			err = opts.Run(cmdOut, config, true, false)
+			if err != nil {
+				klog.Fatalf("Error: %v", err)
+			}
+		},
+	}
+
+	flags := cmd.Flags()
+	opts.GlobalSubcommandBind(flags)
+
+	return cmd
+}
+
+// NewCmdUncordon defines the `uncordon` command that marks a cluster
+// as schedulable.
+func NewCmdUncordon(cmdOut io.Writer, config util.FedConfig) *cobra.Command {
+	opts := &cordonCluster{}
+
+	cmd := &cobra.Command{
+		Use:     "uncordon CLUSTER_NAME --host-cluster-context=HOST_CONTEXT",
+		Short:   "Mark a member cluster as schedulable",
+		Long:    uncordonLong,
+		Example: uncordonExample,
+		Run: func(cmd *cobra.Command, args []string) {
+			err := opts.Complete(args)
+			if err != nil {
+				klog.Fatalf("Error: %v", err)
+			}
+
+			err = opts.Run(cmdOut, config, false, false)
+			if err != nil {
+				klog.Fatalf("Error: %v", err)
+			}
+		},
+	}
+
+	flags := cmd.Flags()
+	opts.GlobalSubcommandBind(flags)
+
+	return cmd
+}
+
+// NewCmdDrain defines the `drain` command that moves resources away
+// from a cluster.
+func NewCmdDrain(cmdOut io.Writer, config util.FedConfig) *cobra.Command {
+	opts := &drainCluster{}
+
+	cmd := &cobra.Command{
+		Use:     "drain CLUSTER_NAME --host-cluster-context=HOST_CONTEXT",
+		Short:   "Move federated resources away from a member cluster",
+		Long:    drainLong,
+		Example: drainExample,
+		Run: func(cmd *cobra.Command, args []string) {
+			err := opts.Complete(args)
+			if err != nil {
+				klog.Fatalf("Error: %v", err)
+			}
+
+			err = opts.Run(cmdOut, config)
+			if err != nil {
+				klog.Fatalf("Error: %v", err)
+			}
+		},
+	}
+
+	flags := cmd.Flags()
+	opts.GlobalSubcommandBind(flags)
+	opts.Bind(flags)
+
+	return cmd
+}
+
+// Complete ensures that options are valid and marshals them if necessary.
+func (o *cordonCluster) Complete(args []string) error {
+	if len(args) == 0 {
+		return errors.New("CLUSTER_NAME is required")
+	}
+	o.clusterName = args[0]
+	return nil
+}
+
+func (o *cordonCluster) hostConfig(config util.FedConfig) (*rest.Config, error) {
+	hostClientConfig := config.GetClientConfig(o.HostClusterContext, o.Kubeconfig)
+	if err := o.SetHostClusterContextFromConfig(hostClientConfig); err != nil {
+		return nil, err
+	}
+	hostConfig, err := hostClientConfig.ClientConfig()
+	if err != nil {
+		return nil, errors.Wrapf(err, "Unable to load configuration for cluster context %q in kubeconfig %q.",
+			o.HostClusterContext, o.Kubeconfig)
+	}
+	return hostConfig, nil
+}
+
+// Run is the implementation of the `cordon` and `uncordon` commands.
+func (o *cordonCluster) Run(cmdOut io.Writer, config util.FedConfig, unschedulable, drain bool) error {
+	hostConfig, err := o.hostConfig(config)
+	if err != nil {
+		return err
+	}
+	return SetClusterSchedulable(cmdOut, hostConfig, o.KubeFedNamespace, o.clusterName, unschedulable, drain, o.DryRun)
+}
+
+// Run is the implementation of the `drain` command.
+func (o *drainCluster) Run(cmdOut io.Writer, config util.FedConfig) error {
+	hostConfig, err := o.hostConfig(config)
+	if err != nil {
+		return err
+	}
+	err = SetClusterSchedulable(cmdOut, hostConfig, o.KubeFedNamespace, o.clusterName, true, true, o.DryRun)
+	if err != nil || o.DryRun || o.timeout == 0 {
+		return err
+	}
+	return WaitForClusterDrain(cmdOut, hostConfig, o.KubeFedNamespace, o.clusterName, o.timeout)
+}
+
+// SetClusterSchedulable updates the unschedulable and drain fields of
+// the named KubeFedCluster.
+func SetClusterSchedulable(cmdOut io.Writer, hostConfig *rest.Config, kubefedNamespace, clusterName string, unschedulable, drain, dryRun bool) error {
+	client, err := genericclient.New(hostConfig)
+	if err != nil {
+		return errors.Wrap(err, "Failed to get kubefed clientset")
+	}
+
+	cluster := &fedv1b1.KubeFedCluster{}
+	err = client.Get(context.TODO(), cluster, kubefedNamespace, clusterName)
+	if err != nil {
+		return errors.Wrapf(err, "Failed to get kubefed cluster \"%s/%s\"", kubefedNamespace, clusterName)
+	}
+
+	var state string
+	switch {
+	case drain:
+		state = "draining"
+	case unschedulable:
+		state = "cordoned"
+	default:
+		state = "uncordoned"
+	}
+	if cluster.Spec.Unschedulable == unschedulable && cluster.Spec.Drain == drain {
+		fmt.Fprintf(cmdOut, "kubefedcluster %q already %s\n", clusterName, state)
+		return nil
+	}
+	if dryRun {
+		return nil
+	}
+
+	cluster.Spec.Unschedulable = unschedulable
+	cluster.Spec.Drain = drain
+	err = client.Update(context.TODO(), cluster)
+	if err != nil {
+		return errors.Wrapf(err, "Failed to update kubefed cluster \"%s/%s\"", kubefedNamespace, clusterName)
+	}
+	fmt.Fprintf(cmdOut, "kubefedcluster %q %s\n", clusterName, state)
+	return nil
+}
+
+// WaitForClusterDrain waits until the federated resources being moved
+// away from the named cluster have been removed from it, reporting
+// the number of remaining resources as it changes.
+func WaitForClusterDrain(cmdOut io.Writer, hostConfig *rest.Config, kubefedNamespace, clusterName string, timeout time.Duration) error {
+	client, err := genericclient.New(hostConfig)
+	if err != nil {
+		return errors.Wrap(err, "Failed to get kubefed clientset")
+	}
+	scope, err := options.GetScopeFromKubeFedConfig(hostConfig, kubefedNamespace)
+	if err != nil {
+		return err
+	}
+	// Resources of a namespace-scoped control plane are limited to
+	// the KubeFed namespace.
+	namespace := metav1.NamespaceAll
+	if scope == apiextv1.NamespaceScoped {
+		namespace = kubefedNamespace
+	}
+
+	var remaining []controllerutil.QualifiedName
+	lastCount := -1
+	err = wait.PollImmediate(drainPollInterval, timeout, func() (bool, error) {
+		remaining, err = drainingResources(hostConfig, client, kubefedNamespace, namespace, clusterName)
+		if err != nil {
+			return false, err
+		}
+		if len(remaining) != lastCount {
+			lastCount = len(remaining)
+			if lastCount > 0 {
+				fmt.Fprintf(cmdOut, "Waiting for %d federated resource(s) to be removed from kubefedcluster %q\n", lastCount, clusterName)
+			}
+		}
+		for _, name := range remaining {
+			klog.V(2).Infof("Waiting for %s to be removed from kubefedcluster %q", name, clusterName)
+		}
+		return len(remaining) == 0, nil
+	})
+	if err == wait.ErrWaitTimeout {
+		return errors.Errorf("Timed out waiting for %d federated resource(s) to be removed from kubefedcluster %q", len(remaining), clusterName)
+	}
+	if err != nil {
+		return err
+	}
+	fmt.Fprintf(cmdOut, "kubefedcluster %q drained\n", clusterName)
+	return nil
+}
+
+// drainingResources returns the names of the federated resources that
+// are still propagated to the named cluster but will be removed from
+// it due to the cluster being drained.
+func drainingResources(hostConfig *rest.Config, client genericclient.Client, kubefedNamespace, namespace, clusterName string) ([]controllerutil.QualifiedName, error) {
+	clusterList := &fedv1b1.KubeFedClusterList{}
+	if err := client.List(context.TODO(), clusterList, kubefedNamespace); err != nil {
+		return nil, errors.Wrap(err, "Failed to list kubefed clusters")
+	}
+	var clusters []*fedv1b1.KubeFedCluster
+	for i := range clusterList.Items {
+		clusters = append(clusters, &clusterList.Items[i])
+	}
+
+	policyList := &policyv1a1.PropagationPolicyList{}
+	if err := client.List(context.TODO(), policyList, namespace); err != nil {
+		return nil, errors.Wrap(err, "Failed to list propagation policies")
+	}
+	var policies []*policyv1a1.PropagationPolicy
+	for i := range policyList.Items {
+		policies = append(policies, &policyList.Items[i])
+	}
+	var clusterPolicies []*policyv1a1.ClusterPropagationPolicy
+	if namespace == metav1.NamespaceAll {
+		clusterPolicyList := &policyv1a1.ClusterPropagationPolicyList{}
+		if err := client.List(context.TODO(), clusterPolicyList, ""); err != nil {
+			return nil, errors.Wrap(err, "Failed to list cluster propagation policies")
+		}
+		for i := range clusterPolicyList.Items {
+			clusterPolicies = append(clusterPolicies, &clusterPolicyList.Items[i])
+		}
+	}
+
+	rspList := &fedschedulingv1a1.ReplicaSchedulingPreferenceList{}
+	if err := client.List(context.TODO(), rspList, namespace); err != nil {
+		return nil, errors.Wrap(err, "Failed to list replica scheduling preferences")
+	}
+	scheduled := sets.String{}
+	for _, rsp := range rspList.Items {
+		scheduled.Insert(rsp.Spec.TargetKind + "/" + rsp.Namespace + "/" + rsp.Name)
+	}
+
+	typeConfigs := &fedv1b1.FederatedTypeConfigList{}
+	if err := client.List(context.TODO(), typeConfigs, kubefedNamespace); err != nil {
+		return nil, errors.Wrap(err, "Failed to list federated type configs")
+	}
+
+	var remaining []controllerutil.QualifiedName
+	for i := range typeConfigs.Items {
+		typeConfig := &typeConfigs.Items[i]
+		if !typeConfig.GetPropagationEnabled() {
+			continue
+		}
+		apiResource := typeConfig.GetFederatedType()
+		resourceClient, err := controllerutil.NewResourceClient(hostConfig, &apiResource)
+		if err != nil {
+			return nil, errors.Wrapf(err, "Error creating client for %s", apiResource.Kind)
+		}
+		resourceList, err := resourceClient.Resources(namespace).List(context.TODO(), metav1.ListOptions{})
+		if err != nil {
+			return nil, errors.Wrapf(err, "Failed to list %s resources", apiResource.Kind)
+		}
+		for j := range resourceList.Items {
+			resource := &resourceList.Items[j]
+			rspScheduled := scheduled.Has(resource.GetKind() + "/" + resource.GetNamespace() + "/" + resource.GetName())
+			pending, err := clusterDrainPending(resource, clusterName, clusters, policies, clusterPolicies, rspScheduled)
+			if err != nil {
+				return nil, err
+			}
+			if pending {
+				remaining = append(remaining, controllerutil.NewQualifiedName(resource))
+			}
+		}
+	}
+	return remaining, nil
+}
+
+// clusterDrainPending indicates whether the given federated resource
+// is still propagated to the named cluster despite not being placed
+// there, either because its placement no longer selects the cluster
+// or because it is scheduled by a ReplicaSchedulingPreference that can
+// move its replicas to another cluster.
+func clusterDrainPending(resource *unstructured.Unstructured, clusterName string, clusters []*fedv1b1.KubeFedCluster,
+	policies []*policyv1a1.PropagationPolicy, clusterPolicies []*policyv1a1.ClusterPropagationPolicy, rspScheduled bool) (bool, error) {
+	placement, err := controllerutil.UnmarshalGenericPlacement(resource)
+	if err != nil {
+		return false, errors.Wrapf(err, "Failed to read placement of %s %q", resource.GetKind(), controllerutil.NewQualifiedName(resource))
+	}
+	propagated := false
+	for _, cluster := range placement.Status.Clusters {
+		if cluster.Name == clusterName {
+			propagated = true
+			break
+		}
+	}
+	if !propagated {
+		return false, nil
+	}
+	if rspScheduled {
+		return replicasMovable(placement, clusterName, clusters), nil
+	}
+
+	resource, _, err = controllerutil.ApplyPropagationPolicy(resource, policies, clusterPolicies)
+	if err != nil {
+		return false, err
+	}
+	selectedClusters, err := controllerutil.ComputePlacement(resource, clusters, false)
+	if err != nil {
+		return false, errors.Wrapf(err, "Failed to compute placement of %s %q", resource.GetKind(), controllerutil.NewQualifiedName(resource))
+	}
+	return !selectedClusters.Has(clusterName), nil
+}
+
+// replicasMovable indicates whether the replicas of a resource
+// scheduled by a ReplicaSchedulingPreference can be moved away from
+// the named cluster. Like the scheduler, which leaves replicas in
+// place if no cluster is schedulable, replicas are only moved to ready
+// clusters that are neither cordoned nor drained, or that are
+// cordoned but already have the resource.
+func replicasMovable(placement *controllerutil.GenericPlacement, clusterName string, clusters []*fedv1b1.KubeFedCluster) bool {
+	propagated := sets.String{}
+	for _, cluster := range placement.Status.Clusters {
+		propagated.Insert(cluster.Name)
+	}
+	for _, cluster := range clusters {
+		if cluster.Name == clusterName || cluster.Spec.Drain || !controllerutil.IsClusterReady(&cluster.Status) {
+			continue
+		}
+		if !cluster.Spec.Unschedulable || propagated.Has(cluster.Name) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,156 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kubefedctl
+
+import (
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"sigs.k8s.io/kubefed/pkg/apis/core/common"
+	fedv1b1 "sigs.k8s.io/kubefed/pkg/apis/core/v1beta1"
+)
+
+func newDrainTestCluster(name string, unschedulable, drain bool) *fedv1b1.KubeFedCluster {
+	return &fedv1b1.KubeFedCluster{
+		ObjectMeta: metav1.ObjectMeta{Name: name},
+		Spec: fedv1b1.KubeFedClusterSpec{
+			Unschedulable: unschedulable,
+			Drain:         drain,
+		},
+		Status: fedv1b1.KubeFedClusterStatus{
+			Conditions: []fedv1b1.ClusterCondition{{
+				Type:   common.ClusterReady,
+				Status: corev1.ConditionTrue,
+			}},
+		},
+	}
+}
+
+func newDrainTestResource(placement map[string]interface{}, propagatedClusters ...string) *unstructured.Unstructured {
+	clusters := []interface{}{}
+	for _, clusterName := range propagatedClusters {
+		clusters = append(clusters, map[string]interface{}{"name": clusterName})
+	}
+	return &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "types.kubefed.io/v1beta1",
+		"kind":       "FederatedDeployment",
+		"metadata": map[string]interface{}{
+			"name":      "foo",
+			"namespace": "bar",
+		},
+		"spec": map[string]interface{}{
+			"placement": placement,
+		},
+		"status": map[string]interface{}{
+			"clusters": clusters,
+		},
+	}}
+}
+
+func TestClusterDrainPending(t *testing.T) {
+	namePlacement := map[string]interface{}{
+		"clusters": []interface{}{
+			map[string]interface{}{"name": "cluster1"},
+			map[string]interface{}{"name": "cluster2"},
+		},
+	}
+	selectorPlacement := map[string]interface{}{
+		"clusterSelector": map[string]interface{}{},
+	}
+
+	testCases := map[string]struct {
+		resource        *unstructured.Unstructured
+		clusters        []*fedv1b1.KubeFedCluster
+		rspScheduled    bool
+		expectedPending bool
+	}{
+		"Resource not propagated to the cluster is not pending": {
+			resource: newDrainTestResource(selectorPlacement, "cluster2"),
+			clusters: []*fedv1b1.KubeFedCluster{
+				newDrainTestCluster("cluster1", true, true),
+				newDrainTestCluster("cluster2", false, false),
+			},
+			expectedPending: false,
+		},
+		"Resource placed by name stays in the drained cluster": {
+			resource: newDrainTestResource(namePlacement, "cluster1", "cluster2"),
+			clusters: []*fedv1b1.KubeFedCluster{
+				newDrainTestCluster("cluster1", true, true),
+				newDrainTestCluster("cluster2", false, false),
+			},
+			expectedPending: false,
+		},
+		"Resource placed by selector is moved from the drained cluster": {
+			resource: newDrainTestResource(selectorPlacement, "cluster1", "cluster2"),
+			clusters: []*fedv1b1.KubeFedCluster{
+				newDrainTestCluster("cluster1", true, true),
+				newDrainTestCluster("cluster2", false, false),
+			},
+			expectedPending: true,
+		},
+		"Resource placed by selector stays in a cordoned cluster": {
+			resource: newDrainTestResource(selectorPlacement, "cluster1", "cluster2"),
+			clusters: []*fedv1b1.KubeFedCluster{
+				newDrainTestCluster("cluster1", true, false),
+				newDrainTestCluster("cluster2", false, false),
+			},
+			expectedPending: false,
+		},
+		"Resource scheduled by RSP is moved from the drained cluster": {
+			resource: newDrainTestResource(namePlacement, "cluster1", "cluster2"),
+			clusters: []*fedv1b1.KubeFedCluster{
+				newDrainTestCluster("cluster1", true, true),
+				newDrainTestCluster("cluster2", false, false),
+			},
+			rspScheduled:    true,
+			expectedPending: true,
+		},
+		"Resource scheduled by RSP is moved to a cordoned cluster it was propagated to": {
+			resource: newDrainTestResource(selectorPlacement, "cluster1", "cluster2"),
+			clusters: []*fedv1b1.KubeFedCluster{
+				newDrainTestCluster("cluster1", true, true),
+				newDrainTestCluster("cluster2", true, false),
+			},
+			rspScheduled:    true,
+			expectedPending: true,
+		},
+		"Resource scheduled by RSP stays when all other clusters are unschedulable": {
+			resource: newDrainTestResource(selectorPlacement, "cluster1"),
+			clusters: []*fedv1b1.KubeFedCluster{
+				newDrainTestCluster("cluster1", true, true),
+				newDrainTestCluster("cluster2", true, false),
+				newDrainTestCluster("cluster3", true, true),
+			},
+			rspScheduled:    true,
+			expectedPending: false,
+		},
+	}
+	for testName, tc := range testCases {
+		t.Run(testName, func(t *testing.T) {
+			pending, err := clusterDrainPending(tc.resource, "cluster1", tc.clusters, nil, nil, tc.rspScheduled)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if pending != tc.expectedPending {
+				t.Fatalf("Expected pending to be %v, got %v", tc.expectedPending, pending)
+			}
+		})
+	}
+}
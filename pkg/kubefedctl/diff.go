@@ -0,0 +1,404 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kubefedctl
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/pmezard/go-difflib/difflib"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+
+	apiextv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/rest"
+	"k8s.io/klog/v2"
+
+	"sigs.k8s.io/kubefed/pkg/apis/core/typeconfig"
+	fedv1b1 "sigs.k8s.io/kubefed/pkg/apis/core/v1beta1"
+	policyv1a1 "sigs.k8s.io/kubefed/pkg/apis/policy/v1alpha1"
+	genericclient "sigs.k8s.io/kubefed/pkg/client/generic"
+	"sigs.k8s.io/kubefed/pkg/controller/sync"
+	ctlutil "sigs.k8s.io/kubefed/pkg/controller/util"
+	"sigs.k8s.io/kubefed/pkg/kubefedctl/enable"
+	"sigs.k8s.io/kubefed/pkg/kubefedctl/options"
+	"sigs.k8s.io/kubefed/pkg/kubefedctl/util"
+)
+
+var (
+	diffLong = `
+		Diff retrieves the resource propagated for a federated
+		resource from each member cluster selected by its placement
+		and prints a unified diff from the retrieved resource to the
+		desired resource. The desired resource is computed as it is
+		by the sync controller, including the fields retained from
+		the resource in the member cluster. Only the fields set by
+		the desired resource are compared. Current context is
+		assumed to be a Kubernetes cluster hosting a KubeFed control
+		plane. Please use the --host-cluster-context flag otherwise.`
+	diffExample = `
+		# Compare the resources propagated for a federated resource
+		# of type FederatedDeployment and named foo with their
+		# desired state
+		kubefedctl diff FederatedDeployment foo --host-cluster-context=bar`
+)
+
+type diffResource struct {
+	options.GlobalSubcommandOptions
+	typeName          string
+	resourceName      string
+	resourceNamespace string
+}
+
+// Bind adds the diff specific arguments to the flagset passed in as
+// an argument.
+func (o *diffResource) Bind(flags *pflag.FlagSet) error {
+	flags.StringVarP(&o.resourceNamespace, "namespace", "n", "", "If present, the namespace scope for this CLI request")
+	return flags.MarkHidden("dry-run")
+}
+
+// NewCmdDiff defines the `diff` command that compares the resources
+// propagated for a federated resource with their desired state.
+func NewCmdDiff(cmdOut io.Writer, config util.FedConfig) *cobra.Command {
+	opts := &diffResource{}
+
+	cmd := &cobra.Command{
+		Use:     "diff <federated type> <name> --host-cluster-context=HOST_CONTEXT",
+		Short:   "Compare the resources propagated for a federated resource with their desired state",
+		Long:    diffLong,
+		Example: diffExample,
+		Run: func(cmd *cobra.Command, args []string) {
+			err := opts.Complete(args, config)
+			if err != nil {
+				klog.Fatalf("Error: %v", err)
+			}
+
+			err = opts.Run(cmdOut, config)
+			if err != nil {
+				klog.Fatalf("Error: %v", err)
+			}
+		},
+	}
+
+	flags := cmd.Flags()
+	opts.GlobalSubcommandBind(flags)
+	err := opts.Bind(flags)
+	if err != nil {
+		klog.Fatalf("Error: %v", err)
+	}
+
+	return cmd
+}
+
+// Complete ensures that options are valid and marshals them if necessary.
+func (o *diffResource) Complete(args []string, config util.FedConfig) error {
+	if len(args) == 0 {
+		return errors.New("resource type is required")
+	}
+	o.typeName = args[0]
+
+	if len(args) == 1 {
+		return errors.New("resource name is required")
+	}
+	o.resourceName = args[1]
+
+	if len(o.resourceNamespace) == 0 {
+		var err error
+		o.resourceNamespace, err = util.GetNamespace(o.HostClusterContext, o.Kubeconfig, config)
+		return err
+	}
+	return nil
+}
+
+// Run is the implementation of the `diff` command.
+func (o *diffResource) Run(cmdOut io.Writer, config util.FedConfig) error {
+	hostClientConfig := config.GetClientConfig(o.HostClusterContext, o.Kubeconfig)
+	if err := o.SetHostClusterContextFromConfig(hostClientConfig); err != nil {
+		return err
+	}
+	hostConfig, err := hostClientConfig.ClientConfig()
+	if err != nil {
+		return errors.Wrapf(err, "Unable to load configuration for cluster context %q in kubeconfig %q.",
+			o.HostClusterContext, o.Kubeconfig)
+	}
+
+	apiResource, err := enable.LookupAPIResource(hostConfig, o.typeName, "")
+	if err != nil {
+		return errors.Wrapf(err, "Failed to find targeted %s type", o.typeName)
+	}
+	klog.V(2).Infof("API Resource for %s/%s found", typeconfig.GroupQualifiedName(*apiResource), apiResource.Version)
+	if !util.IsFederatedAPIResource(apiResource.Kind, apiResource.Group) {
+		return errors.Errorf("%s/%s is not a federated resource", typeconfig.GroupQualifiedName(*apiResource), apiResource.Version)
+	}
+	resourceClient, err := ctlutil.NewResourceClient(hostConfig, apiResource)
+	if err != nil {
+		return errors.Wrapf(err, "Error creating client for %s", apiResource.Kind)
+	}
+	qualifiedName := ctlutil.QualifiedName{Namespace: o.resourceNamespace, Name: o.resourceName}
+	fedResource, err := resourceClient.Resources(o.resourceNamespace).Get(context.Background(), o.resourceName, metav1.GetOptions{})
+	if err != nil {
+		return errors.Wrapf(err, "Failed to retrieve resource: %q", qualifiedName)
+	}
+
+	client, err := genericclient.New(hostConfig)
+	if err != nil {
+		return errors.Wrap(err, "Failed to get kubefed clientset")
+	}
+	input, err := renderInputFromHost(hostConfig, client, o.KubeFedNamespace, fedResource)
+	if err != nil {
+		return err
+	}
+
+	// Placement determines the clusters to retrieve resources from,
+	// and the retrieved resources determine the fields retained in
+	// the desired resources.
+	result, err := sync.Render(input)
+	if err != nil {
+		return errors.Wrapf(err, "Failed to render %s %q", apiResource.Kind, qualifiedName)
+	}
+	clusterNames := make([]string, 0, len(result.Objects))
+	for clusterName := range result.Objects {
+		clusterNames = append(clusterNames, clusterName)
+	}
+	sort.Strings(clusterNames)
+
+	targetType := input.TypeConfig.GetTargetType()
+	input.ClusterObjects = make(map[string]*unstructured.Unstructured)
+	failedClusters := sets.NewString()
+	for _, clusterName := range clusterNames {
+		desiredObj := result.Objects[clusterName]
+		clusterObj, err := getClusterObject(client, o.KubeFedNamespace, input.Clusters, clusterName, &targetType, ctlutil.NewQualifiedName(desiredObj))
+		if err != nil {
+			fmt.Fprintf(cmdOut, "# Failed to retrieve %s %q from cluster %q: %v\n", targetType.Kind, ctlutil.NewQualifiedName(desiredObj), clusterName, err)
+			failedClusters.Insert(clusterName)
+			continue
+		}
+		if clusterObj != nil {
+			input.ClusterObjects[clusterName] = clusterObj
+		}
+	}
+
+	result, err = sync.Render(input)
+	if err != nil {
+		return errors.Wrapf(err, "Failed to render %s %q", apiResource.Kind, qualifiedName)
+	}
+	for _, warning := range result.Warnings {
+		fmt.Fprintf(cmdOut, "# Warning for %s %q: %s\n", apiResource.Kind, qualifiedName, warning)
+	}
+	for _, clusterName := range clusterNames {
+		if failedClusters.Has(clusterName) {
+			continue
+		}
+		err := writeClusterDiff(cmdOut, clusterName, result.Objects[clusterName], input.ClusterObjects[clusterName])
+		if err != nil {
+			return err
+		}
+	}
+
+	if len(failedClusters) > 0 {
+		return errors.Errorf("Failed to retrieve %s %q from clusters %v", targetType.Kind, qualifiedName, failedClusters.List())
+	}
+	return nil
+}
+
+// renderInputFromHost retrieves the state needed to render the given
+// federated resource from the KubeFed control plane.
+func renderInputFromHost(hostConfig *rest.Config, client genericclient.Client, kubefedNamespace string, fedResource *unstructured.Unstructured) (sync.RenderInput, error) {
+	input := sync.RenderInput{Object: fedResource}
+
+	scope, err := options.GetScopeFromKubeFedConfig(hostConfig, kubefedNamespace)
+	if err != nil {
+		return input, err
+	}
+	input.LimitedScope = scope == apiextv1.NamespaceScoped
+
+	typeConfigList := &fedv1b1.FederatedTypeConfigList{}
+	if err := client.List(context.TODO(), typeConfigList, kubefedNamespace); err != nil {
+		return input, errors.Wrap(err, "Failed to list federated type configs")
+	}
+	var typeConfigs []*fedv1b1.FederatedTypeConfig
+	for i := range typeConfigList.Items {
+		typeConfigs = append(typeConfigs, &typeConfigList.Items[i])
+	}
+	typeConfig := typeConfigForFederatedResource(typeConfigs, fedResource.GroupVersionKind())
+	if typeConfig == nil {
+		return input, errors.Errorf("No FederatedTypeConfig found for %s", fedResource.GetKind())
+	}
+	input.TypeConfig = typeConfig
+
+	clusterList := &fedv1b1.KubeFedClusterList{}
+	if err := client.List(context.TODO(), clusterList, kubefedNamespace); err != nil {
+		return input, errors.Wrap(err, "Failed to list kubefed clusters")
+	}
+	for i := range clusterList.Items {
+		input.Clusters = append(input.Clusters, &clusterList.Items[i])
+	}
+
+	namespace := fedResource.GetNamespace()
+	if namespace != "" {
+		policyList := &policyv1a1.PropagationPolicyList{}
+		if err := client.List(context.TODO(), policyList, namespace); err != nil {
+			return input, errors.Wrap(err, "Failed to list propagation policies")
+		}
+		for i := range policyList.Items {
+			input.PropagationPolicies = append(input.PropagationPolicies, &policyList.Items[i])
+		}
+		overridePolicyList := &policyv1a1.OverridePolicyList{}
+		if err := client.List(context.TODO(), overridePolicyList, namespace); err != nil {
+			return input, errors.Wrap(err, "Failed to list override policies")
+		}
+		for i := range overridePolicyList.Items {
+			input.OverridePolicies = append(input.OverridePolicies, &overridePolicyList.Items[i])
+		}
+	}
+	// Cluster-scoped policies are not used by a namespace-scoped
+	// control plane.
+	if !input.LimitedScope {
+		clusterPolicyList := &policyv1a1.ClusterPropagationPolicyList{}
+		if err := client.List(context.TODO(), clusterPolicyList, ""); err != nil {
+			return input, errors.Wrap(err, "Failed to list cluster propagation policies")
+		}
+		for i := range clusterPolicyList.Items {
+			input.ClusterPropagationPolicies = append(input.ClusterPropagationPolicies, &clusterPolicyList.Items[i])
+		}
+		clusterOverridePolicyList := &policyv1a1.ClusterOverridePolicyList{}
+		if err := client.List(context.TODO(), clusterOverridePolicyList, ""); err != nil {
+			return input, errors.Wrap(err, "Failed to list cluster override policies")
+		}
+		for i := range clusterOverridePolicyList.Items {
+			input.ClusterOverridePolicies = append(input.ClusterOverridePolicies, &clusterOverridePolicyList.Items[i])
+		}
+	}
+
+	if typeConfig.GetNamespaced() {
+		input.FederatedNamespace, err = federatedNamespace(hostConfig, typeConfigs, namespace)
+		if err != nil {
+			return input, err
+		}
+	}
+	return input, nil
+}
+
+// federatedNamespace returns the federated namespace of the given
+// namespace, or nil if the namespace is not federated.
+func federatedNamespace(hostConfig *rest.Config, typeConfigs []*fedv1b1.FederatedTypeConfig, namespace string) (*unstructured.Unstructured, error) {
+	for _, typeConfig := range typeConfigs {
+		if typeConfig.GetTargetType().Kind != ctlutil.NamespaceKind {
+			continue
+		}
+		federatedType := typeConfig.GetFederatedType()
+		resourceClient, err := ctlutil.NewResourceClient(hostConfig, &federatedType)
+		if err != nil {
+			return nil, errors.Wrapf(err, "Error creating client for %s", federatedType.Kind)
+		}
+		fedNamespace, err := resourceClient.Resources(namespace).Get(context.Background(), namespace, metav1.GetOptions{})
+		if apierrors.IsNotFound(err) {
+			return nil, nil
+		}
+		if err != nil {
+			return nil, errors.Wrapf(err, "Failed to retrieve %s %q", federatedType.Kind, namespace)
+		}
+		return fedNamespace, nil
+	}
+	return nil, nil
+}
+
+// getClusterObject retrieves the named resource from the named member
+// cluster. Nil is returned if the resource does not exist.
+func getClusterObject(client genericclient.Client, kubefedNamespace string, clusters []*fedv1b1.KubeFedCluster, clusterName string,
+	targetType *metav1.APIResource, qualifiedName ctlutil.QualifiedName) (*unstructured.Unstructured, error) {
+	var cluster *fedv1b1.KubeFedCluster
+	for _, c := range clusters {
+		if c.Name == clusterName {
+			cluster = c
+			break
+		}
+	}
+	if cluster == nil {
+		return nil, errors.Errorf("kubefed cluster %q not found", clusterName)
+	}
+	clusterConfig, err := ctlutil.BuildClusterConfig(cluster, client, kubefedNamespace, ctlutil.ClusterRateLimitConfig{})
+	if err != nil {
+		return nil, err
+	}
+	resourceClient, err := ctlutil.NewResourceClient(clusterConfig, targetType)
+	if err != nil {
+		return nil, errors.Wrapf(err, "Error creating client for %s", targetType.Kind)
+	}
+	clusterObj, err := resourceClient.Resources(qualifiedName.Namespace).Get(context.Background(), qualifiedName.Name, metav1.GetOptions{})
+	if apierrors.IsNotFound(err) {
+		return nil, nil
+	}
+	return clusterObj, err
+}
+
+// writeClusterDiff writes a unified diff from the fields of the
+// cluster object that are set by the desired object to the desired
+// object. A nil cluster object is treated as empty.
+func writeClusterDiff(w io.Writer, clusterName string, desiredObj, clusterObj *unstructured.Unstructured) error {
+	description := fmt.Sprintf("%s %q in cluster %q", desiredObj.GetKind(), ctlutil.NewQualifiedName(desiredObj), clusterName)
+
+	desired, err := yamlLines(ctlutil.ComparedFields(desiredObj, desiredObj))
+	if err != nil {
+		return err
+	}
+	var live []string
+	if clusterObj == nil {
+		fmt.Fprintf(w, "# %s does not exist\n", description)
+	} else {
+		live, err = yamlLines(ctlutil.ComparedFields(desiredObj, clusterObj))
+		if err != nil {
+			return err
+		}
+	}
+
+	diff, err := difflib.GetUnifiedDiffString(difflib.UnifiedDiff{
+		A:        live,
+		FromFile: clusterName + "/live",
+		B:        desired,
+		ToFile:   clusterName + "/desired",
+		Context:  3,
+	})
+	if err != nil {
+		return errors.Wrapf(err, "Failed to compare %s", description)
+	}
+	if diff == "" {
+		fmt.Fprintf(w, "# %s matches the desired state\n", description)
+		return nil
+	}
+	_, err = io.WriteString(w, diff)
+	return err
+}
+
+func yamlLines(obj *unstructured.Unstructured) ([]string, error) {
+	buf := &bytes.Buffer{}
+	err := util.WriteUnstructuredToYaml(obj, buf)
+	if err != nil {
+		return nil, err
+	}
+	// SplitLines terminates the last line with a newline.
+	return difflib.SplitLines(strings.TrimSuffix(buf.String(), "\n")), nil
+}
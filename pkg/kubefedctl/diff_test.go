@@ -0,0 +1,112 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kubefedctl
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	ctlutil "sigs.k8s.io/kubefed/pkg/controller/util"
+)
+
+func newDiffTestObject(data map[string]interface{}, extra map[string]interface{}) *unstructured.Unstructured {
+	obj := &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "v1",
+		"kind":       "ConfigMap",
+		"metadata": map[string]interface{}{
+			"name":      "foo",
+			"namespace": "bar",
+		},
+		"data": data,
+	}}
+	for key, value := range extra {
+		obj.Object[key] = value
+	}
+	return obj
+}
+
+func TestWriteClusterDiff(t *testing.T) {
+	desiredObj := newDiffTestObject(map[string]interface{}{"key": "value"}, nil)
+
+	testCases := map[string]struct {
+		clusterObj *unstructured.Unstructured
+		// Lines expected in the output, in order.
+		expectedLines []string
+	}{
+		"Matching resource": {
+			clusterObj:    newDiffTestObject(map[string]interface{}{"key": "value"}, nil),
+			expectedLines: []string{`# ConfigMap "bar/foo" in cluster "cluster1" matches the desired state`},
+		},
+		"Fields only set in the cluster are ignored": {
+			clusterObj: newDiffTestObject(map[string]interface{}{"key": "value"}, map[string]interface{}{
+				"status":     map[string]interface{}{"phase": "Active"},
+				"immutable":  false,
+				"binaryData": map[string]interface{}{"blob": "AA=="},
+			}),
+			expectedLines: []string{`# ConfigMap "bar/foo" in cluster "cluster1" matches the desired state`},
+		},
+		"Drifted field": {
+			clusterObj: newDiffTestObject(map[string]interface{}{"key": "changed", "other": "value"}, nil),
+			expectedLines: []string{
+				"--- cluster1/live",
+				"+++ cluster1/desired",
+				"-  key: changed",
+				"+  key: value",
+			},
+		},
+		"Missing resource": {
+			expectedLines: []string{
+				`# ConfigMap "bar/foo" in cluster "cluster1" does not exist`,
+				"+apiVersion: v1",
+				"+  key: value",
+			},
+		},
+	}
+	for testName, tc := range testCases {
+		t.Run(testName, func(t *testing.T) {
+			buf := &bytes.Buffer{}
+			if err := writeClusterDiff(buf, "cluster1", desiredObj, tc.clusterObj); err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			output := buf.String()
+			remaining := output
+			for _, line := range tc.expectedLines {
+				index := strings.Index(remaining, line+"\n")
+				if index < 0 {
+					t.Fatalf("Expected line %q in output:\n%s", line, output)
+				}
+				remaining = remaining[index+len(line):]
+			}
+			// The diff must only show the fields that are compared
+			// to detect drift.
+			if strings.Contains(output, "status") || strings.Contains(output, "binaryData") || strings.Contains(output, "other") {
+				t.Fatalf("Expected fields not set by the desired object to be omitted:\n%s", output)
+			}
+			if tc.clusterObj == nil {
+				return
+			}
+			matches := strings.Contains(output, "matches the desired state")
+			drifted := ctlutil.DriftedPaths(desiredObj, tc.clusterObj)
+			if matches != (len(drifted) == 0) {
+				t.Fatalf("Expected the diff to match the drifted paths %v:\n%s", drifted, output)
+			}
+		})
+	}
+}
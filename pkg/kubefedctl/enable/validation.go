@@ -50,47 +50,99 @@ func federatedTypeValidationSchema(templateSchema map[string]v1.JSONSchemaProps)
 							},
 						},
 					},
-					"clusterSelector": {
+					"clusterSelector": labelSelectorSchema(),
+					// Limits the selected clusters to a set spread
+					// across the regions or zones of the clusters.
+					"spreadConstraint": {
 						Type: "object",
 						Properties: map[string]v1.JSONSchemaProps{
-							"matchExpressions": {
+							"spreadBy": {
+								Type: "string",
+								Enum: []v1.JSON{
+									{Raw: []byte(`"Region"`)},
+									{Raw: []byte(`"Zone"`)},
+								},
+							},
+							"maxGroups": {
+								Type:    "integer",
+								Format:  "int32",
+								Minimum: pointer.Float64Ptr(0),
+							},
+							"maxClustersPerGroup": {
+								Type:    "integer",
+								Format:  "int32",
+								Minimum: pointer.Float64Ptr(0),
+							},
+						},
+						Required: []string{
+							"spreadBy",
+						},
+					},
+					// Tolerations allow propagation to clusters with
+					// matching taints.
+					"tolerations": {
+						Type: "array",
+						Items: &v1.JSONSchemaPropsOrArray{
+							Schema: &v1.JSONSchemaProps{
+								Type: "object",
+								Properties: map[string]v1.JSONSchemaProps{
+									"key": {
+										Type: "string",
+									},
+									"operator": {
+										Type: "string",
+									},
+									"value": {
+										Type: "string",
+									},
+									"effect": {
+										Type: "string",
+									},
+									"tolerationSeconds": {
+										Type:   "integer",
+										Format: "int64",
+									},
+								},
+							},
+						},
+					},
+					// Configures the temporary replacement of selected
+					// clusters that are not ready.
+					"failover": {
+						Type: "object",
+						Properties: map[string]v1.JSONSchemaProps{
+							"gracePeriodSeconds": {
+								Type:    "integer",
+								Format:  "int64",
+								Minimum: pointer.Float64Ptr(0),
+							},
+							"backupClusters": {
 								Type: "array",
 								Items: &v1.JSONSchemaPropsOrArray{
 									Schema: &v1.JSONSchemaProps{
 										Type: "object",
 										Properties: map[string]v1.JSONSchemaProps{
-											"key": {
-												Type: "string",
-											},
-											"operator": {
+											"name": {
 												Type: "string",
 											},
-											"values": {
-												Type: "array",
-												Items: &v1.JSONSchemaPropsOrArray{
-													Schema: &v1.JSONSchemaProps{
-														Type: "string",
-													},
-												},
-											},
 										},
 										Required: []string{
-											"key",
-											"operator",
+											"name",
 										},
 									},
 								},
 							},
-							"matchLabels": {
-								Type: "object",
-								AdditionalProperties: &v1.JSONSchemaPropsOrBool{
-									Schema: &v1.JSONSchemaProps{
-										Type: "string",
-									},
-								},
+							"anyMatchingCluster": {
+								Type: "boolean",
 							},
 						},
 					},
+					// Limits the number of selected clusters.
+					"maxClusters": {
+						Type:    "integer",
+						Format:  "int32",
+						Minimum: pointer.Float64Ptr(0),
+					},
 				},
 			},
 			"overrides": {
@@ -102,6 +154,20 @@ func federatedTypeValidationSchema(templateSchema map[string]v1.JSONSchemaProps)
 							"clusterName": {
 								Type: "string",
 							},
+							// Targets the clusters matching the
+							// selector instead of a named cluster.
+							"clusterSelector": labelSelectorSchema(),
+							// Determines whether clusterOverrides,
+							// patch or image is applied.
+							"patchType": {
+								Type: "string",
+								Enum: []v1.JSON{
+									{Raw: []byte(`"json"`)},
+									{Raw: []byte(`"merge"`)},
+									{Raw: []byte(`"strategic"`)},
+									{Raw: []byte(`"image"`)},
+								},
+							},
 							"clusterOverrides": {
 								Type: "array",
 								Items: &v1.JSONSchemaPropsOrArray{
@@ -110,11 +176,14 @@ func federatedTypeValidationSchema(templateSchema map[string]v1.JSONSchemaProps)
 										Properties: map[string]v1.JSONSchemaProps{
 											"op": {
 												Type:    "string",
-												Pattern: "^(add|remove|replace)?$",
+												Pattern: "^(add|remove|replace|move|copy|test)?$",
 											},
 											"path": {
 												Type: "string",
 											},
+											"from": {
+												Type: "string",
+											},
 											"value": {
 												XPreserveUnknownFields: pointer.BoolPtr(true),
 											},
@@ -125,6 +194,82 @@ func federatedTypeValidationSchema(templateSchema map[string]v1.JSONSchemaProps)
 									},
 								},
 							},
+							// The document of a merge or strategic
+							// merge patch.
+							"patch": {
+								Type:                   "object",
+								XPreserveUnknownFields: pointer.BoolPtr(true),
+							},
+							// Rewrites the images of all containers.
+							"image": {
+								Type: "object",
+								Properties: map[string]v1.JSONSchemaProps{
+									"registry": {
+										Type: "string",
+									},
+									"repository": {
+										Type: "string",
+									},
+									"tag": {
+										Type: "string",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			// Stages the propagation of changes across the
+			// selected clusters.
+			"rolloutStrategy": {
+				Type: "object",
+				Properties: map[string]v1.JSONSchemaProps{
+					"canaryClusters": {
+						Type: "array",
+						Items: &v1.JSONSchemaPropsOrArray{
+							Schema: &v1.JSONSchemaProps{
+								Type: "object",
+								Properties: map[string]v1.JSONSchemaProps{
+									"name": {
+										Type: "string",
+									},
+								},
+								Required: []string{
+									"name",
+								},
+							},
+						},
+					},
+					"batchSize": {
+						XIntOrString: true,
+						AnyOf: []v1.JSONSchemaProps{
+							{Type: "integer"},
+							{Type: "string"},
+						},
+					},
+				},
+			},
+			// Federated resources that must be propagated to a
+			// cluster before the resource is created in it.
+			"dependsOn": {
+				Type: "array",
+				Items: &v1.JSONSchemaPropsOrArray{
+					Schema: &v1.JSONSchemaProps{
+						Type: "object",
+						Properties: map[string]v1.JSONSchemaProps{
+							"apiVersion": {
+								Type: "string",
+							},
+							"kind": {
+								Type: "string",
+							},
+							"name": {
+								Type: "string",
+							},
+						},
+						Required: []string{
+							"kind",
+							"name",
 						},
 					},
 				},
@@ -153,6 +298,50 @@ func federatedTypeValidationSchema(templateSchema map[string]v1.JSONSchemaProps)
 	return schema
 }
 
+func labelSelectorSchema() v1.JSONSchemaProps {
+	return v1.JSONSchemaProps{
+		Type: "object",
+		Properties: map[string]v1.JSONSchemaProps{
+			"matchExpressions": {
+				Type: "array",
+				Items: &v1.JSONSchemaPropsOrArray{
+					Schema: &v1.JSONSchemaProps{
+						Type: "object",
+						Properties: map[string]v1.JSONSchemaProps{
+							"key": {
+								Type: "string",
+							},
+							"operator": {
+								Type: "string",
+							},
+							"values": {
+								Type: "array",
+								Items: &v1.JSONSchemaPropsOrArray{
+									Schema: &v1.JSONSchemaProps{
+										Type: "string",
+									},
+								},
+							},
+						},
+						Required: []string{
+							"key",
+							"operator",
+						},
+					},
+				},
+			},
+			"matchLabels": {
+				Type: "object",
+				AdditionalProperties: &v1.JSONSchemaPropsOrBool{
+					Schema: &v1.JSONSchemaProps{
+						Type: "string",
+					},
+				},
+			},
+		},
+	}
+}
+
 func ValidationSchema(specProps v1.JSONSchemaProps) *v1.CustomResourceValidation {
 	return &v1.CustomResourceValidation{
 		OpenAPIV3Schema: &v1.JSONSchemaProps{
@@ -187,6 +376,9 @@ func ValidationSchema(specProps v1.JSONSchemaProps) *v1.CustomResourceValidation
 										"reason": {
 											Type: "string",
 										},
+										"message": {
+											Type: "string",
+										},
 										"lastUpdateTime": {
 											Format: "date-time",
 											Type:   "string",
@@ -219,6 +411,21 @@ func ValidationSchema(specProps v1.JSONSchemaProps) *v1.CustomResourceValidation
 											XPreserveUnknownFields: pointer.BoolPtr(true),
 											Type:                   "object",
 										},
+										// Paths of the fields that differ
+										// from the desired state.
+										"driftedPaths": {
+											Type: "array",
+											Items: &v1.JSONSchemaPropsOrArray{
+												Schema: &v1.JSONSchemaProps{
+													Type: "string",
+												},
+											},
+										},
+										// One of Healthy, Progressing or
+										// Degraded.
+										"health": {
+											Type: "string",
+										},
 									},
 									Required: []string{
 										"name",
@@ -230,6 +437,75 @@ func ValidationSchema(specProps v1.JSONSchemaProps) *v1.CustomResourceValidation
 							Format: "int64",
 							Type:   "integer",
 						},
+						// Explains the placement decision made for
+						// each cluster.
+						"placement": {
+							Type: "array",
+							Items: &v1.JSONSchemaPropsOrArray{
+								Schema: &v1.JSONSchemaProps{
+									Type: "object",
+									Properties: map[string]v1.JSONSchemaProps{
+										"name": {
+											Type: "string",
+										},
+										"reason": {
+											Type: "string",
+										},
+									},
+									Required: []string{
+										"name",
+										"reason",
+									},
+								},
+							},
+						},
+						// Tracks the progress of a staged rollout.
+						"rollout": {
+							Type: "object",
+							Properties: map[string]v1.JSONSchemaProps{
+								"templateVersion": {
+									Type: "string",
+								},
+								"phase": {
+									Type: "string",
+								},
+								"currentBatch": {
+									Type:   "integer",
+									Format: "int32",
+								},
+								"totalBatches": {
+									Type:   "integer",
+									Format: "int32",
+								},
+								"updatedClusters": {
+									Type:   "integer",
+									Format: "int32",
+								},
+								"message": {
+									Type: "string",
+								},
+							},
+						},
+						// Identifies the propagation policy that
+						// provided placement.
+						"propagationPolicy": {
+							Type: "object",
+							Properties: map[string]v1.JSONSchemaProps{
+								"kind": {
+									Type: "string",
+								},
+								"namespace": {
+									Type: "string",
+								},
+								"name": {
+									Type: "string",
+								},
+							},
+							Required: []string{
+								"kind",
+								"name",
+							},
+						},
 					},
 				},
 			},
@@ -65,6 +65,13 @@ func NewKubeFedCtlCommand(out io.Writer) *cobra.Command {
 	rootCmd.AddCommand(federate.NewCmdFederateResource(out, fedConfig))
 	rootCmd.AddCommand(NewCmdJoin(out, fedConfig))
 	rootCmd.AddCommand(NewCmdUnjoin(out, fedConfig))
+	rootCmd.AddCommand(NewCmdCordon(out, fedConfig))
+	rootCmd.AddCommand(NewCmdUncordon(out, fedConfig))
+	rootCmd.AddCommand(NewCmdDrain(out, fedConfig))
+	rootCmd.AddCommand(NewCmdPause(out, fedConfig))
+	rootCmd.AddCommand(NewCmdResume(out, fedConfig))
+	rootCmd.AddCommand(NewCmdRender(out))
+	rootCmd.AddCommand(NewCmdDiff(out, fedConfig))
 	rootCmd.AddCommand(orphaning.NewCmdOrphaning(out, fedConfig))
 	rootCmd.AddCommand(NewCmdVersion(out))
 
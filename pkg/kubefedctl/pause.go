@@ -0,0 +1,212 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kubefedctl
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/klog/v2"
+
+	"sigs.k8s.io/kubefed/pkg/apis/core/typeconfig"
+	ctlutil "sigs.k8s.io/kubefed/pkg/controller/util"
+	"sigs.k8s.io/kubefed/pkg/kubefedctl/enable"
+	"sigs.k8s.io/kubefed/pkg/kubefedctl/options"
+	"sigs.k8s.io/kubefed/pkg/kubefedctl/util"
+)
+
+var (
+	pauseLong = `
+		Pause stops the propagation of a federated resource to
+		member clusters by adding 'kubefed.io/paused: true' as an
+		annotation to the federated resource. Resources in member
+		clusters are neither created, updated nor deleted while
+		propagation is paused, but their status continues to be
+		collected. Current context is assumed to be a Kubernetes
+		cluster hosting a KubeFed control plane. Please use the
+		--host-cluster-context flag otherwise.`
+	pauseExample = `
+		# Pause propagation of a federated resource of type
+		# FederatedDeployment and named foo
+		kubefedctl pause FederatedDeployment foo --host-cluster-context=bar`
+
+	resumeLong = `
+		Resume restarts the propagation of a paused federated
+		resource to member clusters by removing the
+		'kubefed.io/paused' annotation from the federated resource.
+		Current context is assumed to be a Kubernetes cluster
+		hosting a KubeFed control plane. Please use the
+		--host-cluster-context flag otherwise.`
+	resumeExample = `
+		# Resume propagation of a federated resource of type
+		# FederatedDeployment and named foo
+		kubefedctl resume FederatedDeployment foo --host-cluster-context=bar`
+)
+
+type pauseResource struct {
+	options.GlobalSubcommandOptions
+	typeName          string
+	resourceName      string
+	resourceNamespace string
+}
+
+// Bind adds the pause specific arguments to the flagset passed in as
+// an argument.
+func (o *pauseResource) Bind(flags *pflag.FlagSet) error {
+	flags.StringVarP(&o.resourceNamespace, "namespace", "n", "", "If present, the namespace scope for this CLI request")
+	return flags.MarkHidden("kubefed-namespace")
+}
+
+// NewCmdPause defines the `pause` command that pauses propagation of
+// a federated resource.
+func NewCmdPause(cmdOut io.Writer, config util.FedConfig) *cobra.Command {
+	return newCmdPauseResource(cmdOut, config, true)
+}
+
+// NewCmdResume defines the `resume` command that resumes propagation
+// of a federated resource.
+func NewCmdResume(cmdOut io.Writer, config util.FedConfig) *cobra.Command {
+	return newCmdPauseResource(cmdOut, config, false)
+}
+
+func newCmdPauseResource(cmdOut io.Writer, config util.FedConfig, pause bool) *cobra.Command {
+	opts := &pauseResource{}
+
+	cmd := &cobra.Command{
+		Use:     "pause <federated type> <name> --host-cluster-context=HOST_CONTEXT",
+		Short:   "Pause propagation of a federated resource to member clusters",
+		Long:    pauseLong,
+		Example: pauseExample,
+		Run: func(cmd *cobra.Command, args []string) {
+			err := opts.Complete(args, config)
+			if err != nil {
+				klog.Fatalf("Error: %v", err)
+			}
+
+			err = opts.Run(cmdOut, config, pause)
+			if err != nil {
+				klog.Fatalf("Error: %v", err)
+			}
+		},
+	}
+	if !pause {
+		cmd.Use = "resume <federated type> <name> --host-cluster-context=HOST_CONTEXT"
+		cmd.Short = "Resume propagation of a federated resource to member clusters"
+		cmd.Long = resumeLong
+		cmd.Example = resumeExample
+	}
+
+	flags := cmd.Flags()
+	opts.GlobalSubcommandBind(flags)
+	err := opts.Bind(flags)
+	if err != nil {
+		klog.Fatalf("Error: %v", err)
+	}
+
+	return cmd
+}
+
+// Complete ensures that options are valid and marshals them if necessary.
+func (o *pauseResource) Complete(args []string, config util.FedConfig) error {
+	if len(args) == 0 {
+		return errors.New("resource type is required")
+	}
+	o.typeName = args[0]
+
+	if len(args) == 1 {
+		return errors.New("resource name is required")
+	}
+	o.resourceName = args[1]
+
+	if len(o.resourceNamespace) == 0 {
+		var err error
+		o.resourceNamespace, err = util.GetNamespace(o.HostClusterContext, o.Kubeconfig, config)
+		return err
+	}
+	return nil
+}
+
+// Run is the implementation of the `pause` and `resume` commands.
+func (o *pauseResource) Run(cmdOut io.Writer, config util.FedConfig, pause bool) error {
+	hostClientConfig := config.GetClientConfig(o.HostClusterContext, o.Kubeconfig)
+	if err := o.SetHostClusterContextFromConfig(hostClientConfig); err != nil {
+		return err
+	}
+	hostConfig, err := hostClientConfig.ClientConfig()
+	if err != nil {
+		return errors.Wrapf(err, "Unable to load configuration for cluster context %q in kubeconfig %q.",
+			o.HostClusterContext, o.Kubeconfig)
+	}
+
+	apiResource, err := enable.LookupAPIResource(hostConfig, o.typeName, "")
+	if err != nil {
+		return errors.Wrapf(err, "Failed to find targeted %s type", o.typeName)
+	}
+	klog.V(2).Infof("API Resource for %s/%s found", typeconfig.GroupQualifiedName(*apiResource), apiResource.Version)
+	if !util.IsFederatedAPIResource(apiResource.Kind, apiResource.Group) {
+		return errors.Errorf("%s/%s is not a federated resource", typeconfig.GroupQualifiedName(*apiResource), apiResource.Version)
+	}
+	client, err := ctlutil.NewResourceClient(hostConfig, apiResource)
+	if err != nil {
+		return errors.Wrapf(err, "Error creating client for %s", apiResource.Kind)
+	}
+	resourceClient := client.Resources(o.resourceNamespace)
+
+	qualifiedName := ctlutil.QualifiedName{Namespace: o.resourceNamespace, Name: o.resourceName}
+	return setPropagationPaused(cmdOut, resourceClient, apiResource.Kind, qualifiedName, pause, o.DryRun)
+}
+
+// setPropagationPaused pauses or resumes propagation of the named
+// federated resource. A resource that is already in the requested
+// state is not updated.
+func setPropagationPaused(cmdOut io.Writer, resourceClient dynamic.ResourceInterface, kind string, qualifiedName ctlutil.QualifiedName, pause, dryRun bool) error {
+	fedResource, err := resourceClient.Get(context.Background(), qualifiedName.Name, metav1.GetOptions{})
+	if err != nil {
+		return errors.Wrapf(err, "Failed to retrieve resource: %q", qualifiedName)
+	}
+
+	state := "resumed"
+	if pause {
+		state = "paused"
+	}
+	if ctlutil.IsPropagationPaused(fedResource) == pause {
+		fmt.Fprintf(cmdOut, "%s %q already %s\n", kind, qualifiedName, state)
+		return nil
+	}
+	if dryRun {
+		return nil
+	}
+
+	if pause {
+		ctlutil.PausePropagation(fedResource)
+	} else {
+		ctlutil.ResumePropagation(fedResource)
+	}
+	_, err = resourceClient.Update(context.Background(), fedResource, metav1.UpdateOptions{})
+	if err != nil {
+		return errors.Wrapf(err, "Failed to update resource %s %q", kind, qualifiedName)
+	}
+	fmt.Fprintf(cmdOut, "%s %q %s\n", kind, qualifiedName, state)
+	return nil
+}
@@ -0,0 +1,114 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kubefedctl
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+
+	ctlutil "sigs.k8s.io/kubefed/pkg/controller/util"
+)
+
+func TestSetPropagationPaused(t *testing.T) {
+	gvr := schema.GroupVersionResource{Group: "types.kubefed.io", Version: "v1beta1", Resource: "federateddeployments"}
+	qualifiedName := ctlutil.QualifiedName{Namespace: "bar", Name: "foo"}
+
+	testCases := map[string]struct {
+		paused          bool
+		pause           bool
+		dryRun          bool
+		expectedPaused  bool
+		expectedUpdated bool
+		expectedOutput  string
+	}{
+		"Pause pauses propagation": {
+			pause:           true,
+			expectedPaused:  true,
+			expectedUpdated: true,
+			expectedOutput:  "paused",
+		},
+		"Pause of a paused resource does not update it": {
+			paused:         true,
+			pause:          true,
+			expectedPaused: true,
+			expectedOutput: "already paused",
+		},
+		"Resume resumes propagation": {
+			paused:          true,
+			expectedUpdated: true,
+			expectedOutput:  "resumed",
+		},
+		"Resume of a resource that is not paused does not update it": {
+			expectedOutput: "already resumed",
+		},
+		"Dry run does not update the resource": {
+			pause:  true,
+			dryRun: true,
+		},
+	}
+	for testName, tc := range testCases {
+		t.Run(testName, func(t *testing.T) {
+			fedResource := &unstructured.Unstructured{}
+			fedResource.SetAPIVersion("types.kubefed.io/v1beta1")
+			fedResource.SetKind("FederatedDeployment")
+			fedResource.SetNamespace(qualifiedName.Namespace)
+			fedResource.SetName(qualifiedName.Name)
+			fedResource.SetResourceVersion("1")
+			if tc.paused {
+				ctlutil.PausePropagation(fedResource)
+			}
+			client := dynamicfake.NewSimpleDynamicClientWithCustomListKinds(runtime.NewScheme(),
+				map[schema.GroupVersionResource]string{gvr: "FederatedDeploymentList"}, fedResource)
+			resourceClient := client.Resource(gvr).Namespace(qualifiedName.Namespace)
+
+			cmdOut := &bytes.Buffer{}
+			err := setPropagationPaused(cmdOut, resourceClient, "FederatedDeployment", qualifiedName, tc.pause, tc.dryRun)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+
+			updatedResource, err := resourceClient.Get(context.Background(), qualifiedName.Name, metav1.GetOptions{})
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if paused := ctlutil.IsPropagationPaused(updatedResource); paused != tc.expectedPaused {
+				t.Fatalf("Expected paused to be %v, got %v", tc.expectedPaused, paused)
+			}
+			updated := false
+			for _, action := range client.Actions() {
+				if action.GetVerb() == "update" {
+					updated = true
+				}
+			}
+			if updated != tc.expectedUpdated {
+				t.Fatalf("Expected updated to be %v, got %v", tc.expectedUpdated, updated)
+			}
+			output := strings.TrimSpace(cmdOut.String())
+			if tc.expectedOutput == "" && output != "" || !strings.HasSuffix(output, tc.expectedOutput) {
+				t.Fatalf("Expected output ending in %q, got %q", tc.expectedOutput, output)
+			}
+		})
+	}
+}
@@ -0,0 +1,275 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kubefedctl
+
+import (
+	"fmt"
+	"io"
+	"sort"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+
+	apiextv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/klog/v2"
+
+	fedv1b1 "sigs.k8s.io/kubefed/pkg/apis/core/v1beta1"
+	policyv1a1 "sigs.k8s.io/kubefed/pkg/apis/policy/v1alpha1"
+	"sigs.k8s.io/kubefed/pkg/controller/sync"
+	ctlutil "sigs.k8s.io/kubefed/pkg/controller/util"
+	"sigs.k8s.io/kubefed/pkg/kubefedctl/federate"
+	"sigs.k8s.io/kubefed/pkg/kubefedctl/options"
+	"sigs.k8s.io/kubefed/pkg/kubefedctl/util"
+)
+
+var (
+	renderLong = `
+		Render prints the resources that KubeFed would propagate to
+		each member cluster for the federated resources read from
+		the given files. Placement, overrides and propagation and
+		override policies are applied as they are by the sync
+		controller, but no cluster is contacted.
+
+		The files must also contain the FederatedTypeConfig of each
+		federated resource and the KubeFedCluster resources of the
+		member clusters to consider. They may contain the
+		FederatedNamespace of a namespaced federated resource,
+		propagation and override policies, and a KubeFedConfig
+		whose scope determines whether the control plane is
+		namespace-scoped.`
+	renderExample = `
+		# Render the resources propagated to member clusters for
+		# the federated resources in foo.yaml, using the type
+		# configs and clusters in fixtures.yaml
+		kubefedctl render -f foo.yaml -f fixtures.yaml`
+)
+
+type renderResources struct {
+	filenames []string
+}
+
+// Bind adds the render specific arguments to the flagset passed in
+// as an argument.
+func (o *renderResources) Bind(flags *pflag.FlagSet) {
+	flags.StringArrayVarP(&o.filenames, "filename", "f", nil, "A file containing resources to render or the resources needed to render them. Use '-' to read from stdin. May be repeated.")
+}
+
+// NewCmdRender defines the `render` command that prints the
+// resources propagated to member clusters for federated resources.
+func NewCmdRender(cmdOut io.Writer) *cobra.Command {
+	opts := &renderResources{}
+
+	cmd := &cobra.Command{
+		Use:     "render -f FILENAME",
+		Short:   "Print the resources propagated to member clusters for federated resources",
+		Long:    renderLong,
+		Example: renderExample,
+		Run: func(cmd *cobra.Command, args []string) {
+			err := opts.Complete(args)
+			if err != nil {
+				klog.Fatalf("Error: %v", err)
+			}
+
+			err = opts.Run(cmdOut)
+			if err != nil {
+				klog.Fatalf("Error: %v", err)
+			}
+		},
+	}
+
+	opts.Bind(cmd.Flags())
+
+	return cmd
+}
+
+// Complete ensures that options are valid and marshals them if necessary.
+func (o *renderResources) Complete(args []string) error {
+	if len(args) > 0 {
+		return errors.New("render does not accept arguments, use --filename instead")
+	}
+	if len(o.filenames) == 0 {
+		return errors.New("at least one --filename is required")
+	}
+	return nil
+}
+
+// Run is the implementation of the `render` command.
+func (o *renderResources) Run(cmdOut io.Writer) error {
+	var objs []*unstructured.Unstructured
+	for _, filename := range o.filenames {
+		fileObjs, err := federate.DecodeUnstructuredFromFile(filename)
+		if err != nil {
+			return errors.Wrapf(err, "Failed to read resources from %q", filename)
+		}
+		objs = append(objs, fileObjs...)
+	}
+
+	inputs, err := renderInputs(objs)
+	if err != nil {
+		return err
+	}
+	if len(inputs) == 0 {
+		return errors.New("no federated resources found to render")
+	}
+
+	for _, input := range inputs {
+		result, err := sync.Render(input)
+		if err != nil {
+			return errors.Wrapf(err, "Failed to render %s %q", input.Object.GetKind(), ctlutil.NewQualifiedName(input.Object))
+		}
+		err = writeRenderResult(cmdOut, input.Object, result)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// renderInputs sorts the given resources into the inputs for
+// rendering each federated resource among them.
+func renderInputs(objs []*unstructured.Unstructured) ([]sync.RenderInput, error) {
+	var (
+		typeConfigs                []*fedv1b1.FederatedTypeConfig
+		clusters                   []*fedv1b1.KubeFedCluster
+		propagationPolicies        []*policyv1a1.PropagationPolicy
+		clusterPropagationPolicies []*policyv1a1.ClusterPropagationPolicy
+		overridePolicies           []*policyv1a1.OverridePolicy
+		clusterOverridePolicies    []*policyv1a1.ClusterOverridePolicy
+		fedObjs                    []*unstructured.Unstructured
+		fedConfig                  *fedv1b1.KubeFedConfig
+	)
+	fedNamespaces := make(map[string]*unstructured.Unstructured)
+
+	for _, obj := range objs {
+		gvk := obj.GroupVersionKind()
+		var typedObj interface{}
+		switch gvk.GroupKind() {
+		case fedv1b1.SchemeGroupVersion.WithKind("FederatedTypeConfig").GroupKind():
+			typeConfig := &fedv1b1.FederatedTypeConfig{}
+			typeConfigs = append(typeConfigs, typeConfig)
+			typedObj = typeConfig
+		case fedv1b1.SchemeGroupVersion.WithKind("KubeFedCluster").GroupKind():
+			cluster := &fedv1b1.KubeFedCluster{}
+			clusters = append(clusters, cluster)
+			typedObj = cluster
+		case fedv1b1.SchemeGroupVersion.WithKind("KubeFedConfig").GroupKind():
+			fedConfig = &fedv1b1.KubeFedConfig{}
+			typedObj = fedConfig
+		case policyv1a1.SchemeGroupVersion.WithKind(ctlutil.PropagationPolicyKind).GroupKind():
+			policy := &policyv1a1.PropagationPolicy{}
+			propagationPolicies = append(propagationPolicies, policy)
+			typedObj = policy
+		case policyv1a1.SchemeGroupVersion.WithKind(ctlutil.ClusterPropagationPolicyKind).GroupKind():
+			policy := &policyv1a1.ClusterPropagationPolicy{}
+			clusterPropagationPolicies = append(clusterPropagationPolicies, policy)
+			typedObj = policy
+		case policyv1a1.SchemeGroupVersion.WithKind(ctlutil.OverridePolicyKind).GroupKind():
+			policy := &policyv1a1.OverridePolicy{}
+			overridePolicies = append(overridePolicies, policy)
+			typedObj = policy
+		case policyv1a1.SchemeGroupVersion.WithKind(ctlutil.ClusterOverridePolicyKind).GroupKind():
+			policy := &policyv1a1.ClusterOverridePolicy{}
+			clusterOverridePolicies = append(clusterOverridePolicies, policy)
+			typedObj = policy
+		default:
+			if isFederatedNamespace(gvk) {
+				fedNamespaces[obj.GetName()] = obj
+			}
+			fedObjs = append(fedObjs, obj)
+			continue
+		}
+		err := runtime.DefaultUnstructuredConverter.FromUnstructured(obj.Object, typedObj)
+		if err != nil {
+			return nil, errors.Wrapf(err, "Failed to decode %s %q", gvk.Kind, ctlutil.NewQualifiedName(obj))
+		}
+	}
+	limitedScope := fedConfig != nil && fedConfig.Spec.Scope == apiextv1.NamespaceScoped
+
+	var inputs []sync.RenderInput
+	for _, obj := range fedObjs {
+		typeConfig := typeConfigForFederatedResource(typeConfigs, obj.GroupVersionKind())
+		if typeConfig == nil {
+			if isFederatedNamespace(obj.GroupVersionKind()) {
+				// Only needed to place namespaced resources.
+				continue
+			}
+			return nil, errors.Errorf("No FederatedTypeConfig found for %s %q", obj.GetKind(), ctlutil.NewQualifiedName(obj))
+		}
+		input := sync.RenderInput{
+			Object:                     obj,
+			TypeConfig:                 typeConfig,
+			Clusters:                   clusters,
+			LimitedScope:               limitedScope,
+			PropagationPolicies:        propagationPolicies,
+			ClusterPropagationPolicies: clusterPropagationPolicies,
+			OverridePolicies:           overridePolicies,
+			ClusterOverridePolicies:    clusterOverridePolicies,
+		}
+		if typeConfig.GetNamespaced() {
+			input.FederatedNamespace = fedNamespaces[obj.GetNamespace()]
+		}
+		inputs = append(inputs, input)
+	}
+	return inputs, nil
+}
+
+func isFederatedNamespace(gvk schema.GroupVersionKind) bool {
+	return gvk.Group == options.DefaultFederatedGroup && gvk.Kind == util.FederatedKindPrefix+ctlutil.NamespaceKind
+}
+
+func typeConfigForFederatedResource(typeConfigs []*fedv1b1.FederatedTypeConfig, gvk schema.GroupVersionKind) *fedv1b1.FederatedTypeConfig {
+	for _, typeConfig := range typeConfigs {
+		federatedType := typeConfig.GetFederatedType()
+		if federatedType.Group == gvk.Group && federatedType.Kind == gvk.Kind {
+			return typeConfig
+		}
+	}
+	return nil
+}
+
+// writeRenderResult writes the resources rendered for a federated
+// resource as yaml documents. Warnings and clusters that were not
+// selected are written as comments.
+func writeRenderResult(w io.Writer, fedObj *unstructured.Unstructured, result *sync.RenderResult) error {
+	description := fmt.Sprintf("%s %q", fedObj.GetKind(), ctlutil.NewQualifiedName(fedObj))
+	for _, warning := range result.Warnings {
+		fmt.Fprintf(w, "# Warning for %s: %s\n", description, warning)
+	}
+
+	var clusterNames []string
+	for clusterName := range result.PlacementDecisions {
+		clusterNames = append(clusterNames, clusterName)
+	}
+	sort.Strings(clusterNames)
+	for _, clusterName := range clusterNames {
+		obj, ok := result.Objects[clusterName]
+		if !ok {
+			fmt.Fprintf(w, "# %s is not propagated to cluster %q: %s\n", description, clusterName, result.PlacementDecisions[clusterName])
+			continue
+		}
+		fmt.Fprintf(w, "---\n# %s in cluster %q\n", description, clusterName)
+		err := util.WriteUnstructuredToYaml(obj, w)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
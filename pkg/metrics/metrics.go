@@ -88,6 +88,13 @@ var (
 		}, []string{"action"},
 	)
 
+	driftDetectedTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "drift_detected_total",
+			Help: "Number of times a resource in a member cluster was found to differ from the desired state without being updated.",
+		}, []string{"kind", "cluster"},
+	)
+
 	controllerRuntimeReconcileDuration = prometheus.NewHistogramVec(
 		prometheus.HistogramOpts{
 			Name:    "controller_runtime_reconcile_duration_seconds",
@@ -157,6 +164,7 @@ func RegisterAll() {
 		joinedClusterDuration,
 		unjoinedClusterDuration,
 		dispatchOperationDuration,
+		driftDetectedTotal,
 		controllerRuntimeReconcileDuration,
 		controllerRuntimeReconcileDurationSummary,
 		ControllerRuntimeReconcileTotal,
@@ -201,6 +209,12 @@ func DispatchOperationDurationFromStart(action string, start time.Time) {
 	dispatchOperationDuration.WithLabelValues(action).Observe(duration.Seconds())
 }
 
+// DriftDetectedInc increases by one the number of times drift was
+// detected for a resource of the given kind in the given cluster
+func DriftDetectedInc(kind, cluster string) {
+	driftDetectedTotal.WithLabelValues(kind, cluster).Inc()
+}
+
 // ClusterHealthStatusDurationFromStart records the duration of the cluster health status operation
 func ClusterHealthStatusDurationFromStart(start time.Time) {
 	duration := time.Since(start)
@@ -37,6 +37,7 @@ import (
 	fedv1b1 "sigs.k8s.io/kubefed/pkg/apis/core/v1beta1"
 	genericclient "sigs.k8s.io/kubefed/pkg/client/generic"
 	"sigs.k8s.io/kubefed/pkg/controller/util"
+	"sigs.k8s.io/kubefed/pkg/interpreter"
 )
 
 const (
@@ -52,6 +53,7 @@ type Plugin struct {
 	federatedTypeClient util.ResourceClient
 
 	typeConfig   typeconfig.Interface
+	interpreter  interpreter.Interpreter
 	fedNsClient  util.ResourceClient
 	limitedScope bool
 
@@ -76,9 +78,15 @@ func NewPlugin(controllerConfig *util.ControllerConfig, eventHandlers SchedulerE
 		return nil, err
 	}
 
+	interp, err := interpreter.ForTypeConfig(typeConfig)
+	if err != nil {
+		return nil, err
+	}
+
 	p := &Plugin{
 		targetInformer: targetInformer,
 		typeConfig:     typeConfig,
+		interpreter:    interp,
 		limitedScope:   controllerConfig.LimitedScope(),
 		stopChannel:    make(chan struct{}),
 	}
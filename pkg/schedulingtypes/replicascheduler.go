@@ -38,6 +38,7 @@ import (
 	ctlutil "sigs.k8s.io/kubefed/pkg/controller/util"
 	"sigs.k8s.io/kubefed/pkg/controller/util/planner"
 	"sigs.k8s.io/kubefed/pkg/controller/util/podanalyzer"
+	"sigs.k8s.io/kubefed/pkg/interpreter"
 )
 
 const (
@@ -214,6 +215,11 @@ func (s *ReplicaScheduler) Reconcile(obj runtimeclient.Object, qualifiedName ctl
 		klog.V(3).Infof("Preferred clusters %q", clusterNames)
 	}
 
+	clusterNames = s.schedulableClusterNames(plugin.(*Plugin), fedClusters, clusterNames, key)
+	if len(clusterNames) == 0 {
+		return ctlutil.StatusAllOK
+	}
+
 	result, status, err := s.GetSchedulingResult(rsp, qualifiedName, clusterNames)
 	if err != nil {
 		runtime.HandleError(errors.Wrapf(err, "Failed to compute the schedule information while reconciling RSP named %q", key))
@@ -239,6 +245,39 @@ func (s *ReplicaScheduler) clusterNames(clusters []*fedv1b1.KubeFedCluster) []st
 	return clusterNames
 }
 
+// schedulableClusterNames removes the names of clusters that replicas
+// should not be scheduled to.  Replicas are moved away from clusters
+// that are being drained, and are only scheduled to a cordoned
+// cluster if the target resource already exists in it.
+func (s *ReplicaScheduler) schedulableClusterNames(plugin *Plugin, clusters []*fedv1b1.KubeFedCluster, clusterNames []string, key string) []string {
+	unschedulable := make(map[string]*fedv1b1.KubeFedCluster)
+	for _, cluster := range clusters {
+		if ctlutil.IsClusterUnschedulable(cluster) {
+			unschedulable[cluster.Name] = cluster
+		}
+	}
+	schedulable := []string{}
+	for _, clusterName := range clusterNames {
+		cluster, ok := unschedulable[clusterName]
+		if !ok {
+			schedulable = append(schedulable, clusterName)
+			continue
+		}
+		if cluster.Spec.Drain {
+			continue
+		}
+		_, exists, err := plugin.targetInformer.GetTargetStore().GetByKey(clusterName, key)
+		if err != nil {
+			runtime.HandleError(errors.Wrapf(err, "Failed to query target store of cluster %q for %q", clusterName, key))
+			continue
+		}
+		if exists {
+			schedulable = append(schedulable, clusterName)
+		}
+	}
+	return schedulable
+}
+
 func (s *ReplicaScheduler) GetSchedulingResult(rsp *fedschedulingv1a1.ReplicaSchedulingPreference,
 	qualifiedName ctlutil.QualifiedName, clusterNames []string) (map[string]int64, ctlutil.ReconciliationStatus, error) {
 	key := qualifiedName.String()
@@ -250,6 +289,13 @@ func (s *ReplicaScheduler) GetSchedulingResult(rsp *fedschedulingv1a1.ReplicaSch
 		}
 		return plugin.(*Plugin).targetInformer.GetTargetStore().GetByKey(clusterName, key)
 	}
+	replicasGetter := func(objs map[string]*unstructured.Unstructured) (map[string]interpreter.Replicas, error) {
+		plugin, ok := s.plugins.Get(rsp.Spec.TargetKind)
+		if !ok {
+			return nil, nil
+		}
+		return interpreter.ReplicasForClusters(plugin.(*Plugin).interpreter, objs)
+	}
 	podsGetter := func(clusterName string, unstructuredObj *unstructured.Unstructured) (*corev1.PodList, error) {
 		client, err := s.podInformer.GetClientForCluster(clusterName)
 		if err != nil {
@@ -271,7 +317,7 @@ func (s *ReplicaScheduler) GetSchedulingResult(rsp *fedschedulingv1a1.ReplicaSch
 		return podList, nil
 	}
 
-	currentReplicasPerCluster, estimatedCapacity, status, err := clustersReplicaState(clusterNames, key, objectGetter, podsGetter)
+	currentReplicasPerCluster, estimatedCapacity, status, err := clustersReplicaState(clusterNames, key, objectGetter, replicasGetter, podsGetter)
 	if err != nil {
 		return nil, status, err
 	}
@@ -336,36 +382,38 @@ func clustersReplicaState(
 	clusterNames []string,
 	key string,
 	objectGetter func(clusterName string, key string) (interface{}, bool, error),
+	replicasGetter func(objs map[string]*unstructured.Unstructured) (map[string]interpreter.Replicas, error),
 	podsGetter func(clusterName string, obj *unstructured.Unstructured) (*corev1.PodList, error)) (
 	currentReplicasPerCluster map[string]int64, estimatedCapacity map[string]int64,
 	status ctlutil.ReconciliationStatus, err error) {
 	currentReplicasPerCluster = make(map[string]int64)
 	estimatedCapacity = make(map[string]int64)
 
+	objs := make(map[string]*unstructured.Unstructured)
 	for _, clusterName := range clusterNames {
 		obj, exists, err := objectGetter(clusterName, key)
 		if err != nil {
 			return nil, nil, status, err
 		}
-		if !exists {
-			continue
+		if exists {
+			objs[clusterName] = obj.(*unstructured.Unstructured)
 		}
+	}
 
-		unstructuredObj := obj.(*unstructured.Unstructured)
-		replicas, ok, err := unstructured.NestedInt64(unstructuredObj.Object, "spec", "replicas")
-		if err != nil {
-			return nil, nil, status, errors.Wrap(err, "Error retrieving 'replicas' field")
-		}
-		if !ok {
-			replicas = int64(0)
-		}
-		readyReplicas, ok, err := unstructured.NestedInt64(unstructuredObj.Object, "status", "readyReplicas")
-		if err != nil {
-			return nil, nil, status, errors.Wrap(err, "Error retrieving 'readyreplicas' field")
-		}
+	// The replicas of the resources in all clusters are interpreted
+	// at once.
+	replicasPerCluster, err := replicasGetter(objs)
+	if err != nil {
+		return nil, nil, status, err
+	}
+
+	for _, clusterName := range clusterNames {
+		unstructuredObj, ok := objs[clusterName]
 		if !ok {
-			readyReplicas = int64(0)
+			continue
 		}
+		replicas := replicasPerCluster[clusterName].Replicas
+		readyReplicas := replicasPerCluster[clusterName].ReadyReplicas
 
 		if replicas == readyReplicas {
 			currentReplicasPerCluster[clusterName] = readyReplicas
@@ -38,12 +38,18 @@ type ControllerFixture struct {
 	stopChan chan struct{}
 }
 
+// The stores of the federated resources of the sync controllers
+// started by fixtures, shared between them as they would be by the
+// FederatedTypeConfig controller.
+var federatedStores = sync.NewFederatedStores()
+
 // NewSyncControllerFixture initializes a new sync controller fixture.
 func NewSyncControllerFixture(tl common.TestLogger, controllerConfig *util.ControllerConfig, typeConfig typeconfig.Interface, namespacePlacement *metav1.APIResource) *ControllerFixture {
 	f := &ControllerFixture{
 		stopChan: make(chan struct{}),
 	}
-	err := sync.StartKubeFedSyncController(controllerConfig, f.stopChan, typeConfig, namespacePlacement)
+	f.ensurePolicyInformers(tl, controllerConfig)
+	err := sync.StartKubeFedSyncController(controllerConfig, f.stopChan, typeConfig, namespacePlacement, federatedStores)
 	if err != nil {
 		tl.Fatalf("Error starting sync controller: %v", err)
 	}
@@ -62,6 +68,20 @@ func NewSyncControllerFixture(tl common.TestLogger, controllerConfig *util.Contr
 	return f
 }
 
+// ensurePolicyInformers runs policy informers for the lifetime of the
+// fixture if the given configuration does not provide them.
+func (f *ControllerFixture) ensurePolicyInformers(tl common.TestLogger, controllerConfig *util.ControllerConfig) {
+	if controllerConfig.PolicyInformers != nil {
+		return
+	}
+	policyInformers, err := util.NewPolicyInformers(controllerConfig)
+	if err != nil {
+		tl.Fatalf("Error creating policy informers: %v", err)
+	}
+	policyInformers.Run(f.stopChan)
+	controllerConfig.PolicyInformers = policyInformers
+}
+
 // NewFederatedTypeConfigControllerFixure initializes a new federatedtypeconfig
 // controller fixure.
 func NewFederatedTypeConfigControllerFixture(tl common.TestLogger, config *util.ControllerConfig) *ControllerFixture {
@@ -69,6 +89,7 @@ func NewFederatedTypeConfigControllerFixture(tl common.TestLogger, config *util.
 		stopChan: make(chan struct{}),
 	}
 
+	f.ensurePolicyInformers(tl, config)
 	err := federatedtypeconfig.StartController(config, f.stopChan)
 	if err != nil {
 		tl.Fatalf("Error starting federatedtypeconfig controller: %v", err)
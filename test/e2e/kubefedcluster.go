@@ -24,7 +24,7 @@ var _ = Describe("KubeFedCluster", func() {
 		clusterList := framework.ListKubeFedClusters(tl, client, framework.TestContext.KubeFedSystemNamespace)
 
 		for _, cluster := range clusterList.Items {
-			config, err := util.BuildClusterConfig(&cluster, client, framework.TestContext.KubeFedSystemNamespace)
+			config, err := util.BuildClusterConfig(&cluster, client, framework.TestContext.KubeFedSystemNamespace, util.ClusterRateLimitConfig{})
 			Expect(err).NotTo(HaveOccurred())
 			restclient.AddUserAgent(config, userAgent)
 
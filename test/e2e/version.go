@@ -154,6 +154,10 @@ func (r *testVersionedResource) OverrideVersion() (string, error) {
 	return r.overrideVersion, nil
 }
 
+func (r *testVersionedResource) ClusterOverrideVersion(clusterName string) (string, error) {
+	return "", nil
+}
+
 func newTestVersionAdapter(kubeClient kubeclientset.Interface, namespaced bool) testVersionAdapter {
 	adapter := version.NewVersionAdapter(namespaced)
 	if namespaced {
@@ -287,7 +291,7 @@ var _ = Describe("VersionManager", func() {
 				expectedStatus = fedv1a1.PropagatedVersionStatus{
 					TemplateVersion: templateVersion,
 					OverrideVersion: "",
-					ClusterVersions: version.VersionMapToClusterVersions(versionMap),
+					ClusterVersions: version.VersionMapToClusterVersions(versionMap, templateVersion, nil),
 				}
 
 				versionManager = version.NewVersionManager(client, namespaced, federatedKind, targetKind, versionNamespace)
@@ -356,7 +360,7 @@ var _ = Describe("VersionManager", func() {
 				if err != nil {
 					tl.Fatalf("Error updating version status: %v", err)
 				}
-				expectedStatus.ClusterVersions = version.VersionMapToClusterVersions(versionMap)
+				expectedStatus.ClusterVersions = version.VersionMapToClusterVersions(versionMap, expectedStatus.TemplateVersion, nil)
 				waitForPropVer(tl, adapter, client, versionName, expectedStatus)
 			})
 
@@ -390,7 +394,7 @@ var _ = Describe("VersionManager", func() {
 				if err != nil {
 					tl.Fatalf("Error updating version status: %v", err)
 				}
-				expectedStatus.ClusterVersions = version.VersionMapToClusterVersions(versionMap)
+				expectedStatus.ClusterVersions = version.VersionMapToClusterVersions(versionMap, expectedStatus.TemplateVersion, nil)
 				waitForPropVer(tl, adapter, client, versionName, expectedStatus)
 			})
 
@@ -413,7 +417,7 @@ var _ = Describe("VersionManager", func() {
 				if err != nil {
 					tl.Fatalf("Error updating version status: %v", err)
 				}
-				expectedStatus.ClusterVersions = version.VersionMapToClusterVersions(versionMap)
+				expectedStatus.ClusterVersions = version.VersionMapToClusterVersions(versionMap, expectedStatus.TemplateVersion, nil)
 				waitForPropVer(tl, adapter, client, versionName, expectedStatus)
 			})
 